@@ -0,0 +1,54 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import * as pulumi from "@pulumi/pulumi";
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+const config = new pulumi.Config();
+const adminPassword = config.requireSecret("adminPassword");
+
+const network = new yandex.vpc.Network("data", {});
+const subnet = new yandex.vpc.Subnet("data", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.30.0.0/24"],
+});
+
+const folderId = new pulumi.Config("yandex").require("folderId");
+const serviceAccount = new yandex.iam.ServiceAccount("data", {});
+new yandex.resourcemanager.FolderIamMember("airflow-integration", {
+    folderId: folderId,
+    role: "managed-airflow.integrationProvider",
+    member: pulumi.interpolate`serviceAccount:${serviceAccount.id}`,
+});
+
+const dags = new yandex.storage.Bucket("dags", {});
+
+const cluster = new yandex.airflow.Cluster("airflow", {
+    serviceAccountId: serviceAccount.id,
+    subnetIds: [subnet.id],
+    adminPassword: adminPassword,
+    codeSync: {
+        s3: { bucket: dags.bucket },
+    },
+    webserver: { count: 1, resourcePresetId: "c1-m4" },
+    scheduler: { count: 1, resourcePresetId: "c1-m4" },
+    worker: { minCount: 1, maxCount: 2, resourcePresetId: "c1-m4" },
+    airflowConfig: {
+        core: { load_examples: "False" },
+    },
+});
+
+export const clusterId = cluster.id;
@@ -13,7 +13,7 @@ func getGoBaseOptions(t *testing.T) integration.ProgramTestOptions {
 	base := integration.ProgramTestOptions{}
 	baseGo := base.With(integration.ProgramTestOptions{
 		Dependencies: []string{
-			"github.com/pulumi/pulumi-yandex/sdk",
+			"github.com/airoh-io/pulumi-yandex/sdk",
 		},
 	})
 
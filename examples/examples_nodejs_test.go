@@ -19,11 +19,87 @@ func TestAccVpcNetworkTs(t *testing.T) {
 	integration.ProgramTest(t, &test)
 }
 
+func TestAccMdbOpensearchTs(t *testing.T) {
+	test := getJSBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir:     path.Join(getCwd(t), "mdb-opensearch", "ts"),
+			Secrets: map[string]string{"adminPassword": "Pulumi-acc-test-1"},
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
+func TestAccMdbPostgresqlV2MoveTs(t *testing.T) {
+	dir := path.Join(getCwd(t), "mdb-postgresql-v2", "ts")
+
+	// The cluster must keep its ID through every step: the v2 resource adopts the cluster the
+	// v1 resource created instead of replacing it.
+	var clusterID interface{}
+	sameCluster := func(t *testing.T, stack integration.RuntimeValidationStackInfo) {
+		id := stack.Outputs["clusterId"]
+		if clusterID == nil {
+			clusterID = id
+		} else if id != clusterID {
+			t.Errorf("cluster ID changed from %v to %v", clusterID, id)
+		}
+	}
+
+	test := getJSBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir:                    dir,
+			ExtraRuntimeValidation: sameCluster,
+			EditDirs: []integration.EditDir{
+				{Dir: path.Join(dir, "step2"), Additive: true, ExtraRuntimeValidation: sameCluster},
+				{Dir: path.Join(dir, "step3"), Additive: true, ExpectNoChanges: true, ExtraRuntimeValidation: sameCluster},
+			},
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
+func TestAccAirflowClusterTs(t *testing.T) {
+	test := getJSBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir:     path.Join(getCwd(t), "airflow-cluster", "ts"),
+			Secrets: map[string]string{"adminPassword": "Pulumi-acc-test-1"},
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
+func TestAccTrinoClusterTs(t *testing.T) {
+	test := getJSBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir:     path.Join(getCwd(t), "trino-cluster", "ts"),
+			Secrets: map[string]string{"warehousePassword": "Pulumi-acc-test-1"},
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
+func TestAccSparkClusterTs(t *testing.T) {
+	test := getJSBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir: path.Join(getCwd(t), "spark-cluster", "ts"),
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
+func TestAccMetastoreClusterTs(t *testing.T) {
+	test := getJSBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir: path.Join(getCwd(t), "metastore-cluster", "ts"),
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
 func getJSBaseOptions(t *testing.T) integration.ProgramTestOptions {
 	base := integration.ProgramTestOptions{}
 	baseJS := base.With(integration.ProgramTestOptions{
 		Dependencies: []string{
-			"@pulumi/yandex",
+			"@airoh-io/pulumi-yandex",
 		},
 	})
 
@@ -19,6 +19,16 @@ func TestAccVpcNetworkTs(t *testing.T) {
 	integration.ProgramTest(t, &test)
 }
 
+func TestAccMdbOpensearchPy(t *testing.T) {
+	test := getPythonBaseOptions(t).
+		With(integration.ProgramTestOptions{
+			Dir:     filepath.Join(getCwd(t), "mdb-opensearch", "py"),
+			Secrets: map[string]string{"adminPassword": "Pulumi-acc-test-1"},
+		})
+
+	integration.ProgramTest(t, &test)
+}
+
 func getPythonBaseOptions(t *testing.T) integration.ProgramTestOptions {
 	base := integration.ProgramTestOptions{}
 	basePy := base.With(integration.ProgramTestOptions{
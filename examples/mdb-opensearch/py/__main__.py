@@ -0,0 +1,63 @@
+"""A Managed OpenSearch cluster with a dashboards node group"""
+
+import pulumi
+import pulumi_yandex as yandex
+
+config = pulumi.Config()
+admin_password = config.require_secret("adminPassword")
+
+network = yandex.vpc.Network("search")
+subnet = yandex.vpc.Subnet(
+    "search",
+    network_id=network.id,
+    zone=yandex.Zone.RU_CENTRAL1_A,
+    v4_cidr_blocks=["10.10.0.0/24"],
+)
+
+resources = yandex.mdb.OpensearchClusterConfigOpensearchNodeGroupResourcesArgs(
+    resource_preset_id="s2.micro",
+    disk_size=10737418240,
+    disk_type_id="network-ssd",
+)
+
+cluster = yandex.mdb.OpensearchCluster(
+    "search",
+    environment=yandex.mdb.Environment.PRODUCTION,
+    network_id=network.id,
+    config=yandex.mdb.OpensearchClusterConfigArgs(
+        admin_password=admin_password,
+        opensearch=yandex.mdb.OpensearchClusterConfigOpensearchArgs(
+            node_groups=[
+                yandex.mdb.OpensearchClusterConfigOpensearchNodeGroupArgs(
+                    name="data",
+                    hosts_count=1,
+                    zone_ids=[yandex.Zone.RU_CENTRAL1_A],
+                    subnet_ids=[subnet.id],
+                    roles=[
+                        yandex.mdb.OpensearchNodeGroupRole.DATA,
+                        yandex.mdb.OpensearchNodeGroupRole.MANAGER,
+                    ],
+                    resources=resources,
+                )
+            ],
+        ),
+        dashboards=yandex.mdb.OpensearchClusterConfigDashboardsArgs(
+            node_groups=[
+                yandex.mdb.OpensearchClusterConfigDashboardsNodeGroupArgs(
+                    name="dashboards",
+                    hosts_count=1,
+                    zone_ids=[yandex.Zone.RU_CENTRAL1_A],
+                    subnet_ids=[subnet.id],
+                    assign_public_ip=True,
+                    resources=yandex.mdb.OpensearchClusterConfigDashboardsNodeGroupResourcesArgs(
+                        resource_preset_id="s2.micro",
+                        disk_size=10737418240,
+                        disk_type_id="network-ssd",
+                    ),
+                )
+            ],
+        ),
+    ),
+)
+
+pulumi.export("cluster-id", cluster.id)
@@ -0,0 +1,65 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+const config = new pulumi.Config();
+const adminPassword = config.requireSecret("adminPassword");
+
+const network = new yandex.vpc.Network("search", {});
+const subnet = new yandex.vpc.Subnet("search", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.10.0.0/24"],
+});
+
+const resources = {
+    resourcePresetId: "s2.micro",
+    diskSize: 10737418240,
+    diskTypeId: "network-ssd",
+};
+
+const cluster = new yandex.mdb.OpensearchCluster("search", {
+    environment: yandex.mdb.Environment.Production,
+    networkId: network.id,
+    config: {
+        adminPassword: adminPassword,
+        opensearch: {
+            nodeGroups: [{
+                name: "data",
+                hostsCount: 1,
+                zoneIds: [yandex.Zone.RuCentral1A],
+                subnetIds: [subnet.id],
+                roles: [yandex.mdb.OpensearchNodeGroupRole.Data, yandex.mdb.OpensearchNodeGroupRole.Manager],
+                resources: resources,
+            }],
+        },
+        dashboards: {
+            nodeGroups: [{
+                name: "dashboards",
+                hostsCount: 1,
+                zoneIds: [yandex.Zone.RuCentral1A],
+                subnetIds: [subnet.id],
+                assignPublicIp: true,
+                resources: resources,
+            }],
+        },
+    },
+});
+
+const found = yandex.mdb.getOpensearchClusterOutput({ clusterId: cluster.id });
+
+export const clusterId = cluster.id;
+export const clusterNetworkId = found.networkId;
@@ -0,0 +1,44 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+// Step 1: a cluster managed by the v1 resource. retainOnDelete lets the cluster leave the
+// program later without being deleted.
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+export const clusterName = "pulumi-acc-move";
+
+const network = new yandex.vpc.Network("db", {});
+const subnet = new yandex.vpc.Subnet("db", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.20.0.0/24"],
+});
+
+const cluster = new yandex.mdb.PostgresqlCluster("db", {
+    name: clusterName,
+    environment: yandex.mdb.Environment.Prestable,
+    networkId: network.id,
+    config: {
+        version: "16",
+        resources: {
+            resourcePresetId: "s2.micro",
+            diskSize: 10,
+            diskTypeId: "network-ssd",
+        },
+    },
+    hosts: [{ zone: yandex.Zone.RuCentral1A, subnetId: subnet.id }],
+}, { retainOnDelete: true });
+
+export const clusterId = cluster.id;
@@ -0,0 +1,51 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+// Step 2: the v1 resource is gone from the program and the v2 resource adopts the same cluster
+// through the import option. The update imports the cluster and drops the v1 resource from state
+// without deleting it.
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+const clusterName = "pulumi-acc-move";
+
+const network = new yandex.vpc.Network("db", {});
+const subnet = new yandex.vpc.Subnet("db", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.20.0.0/24"],
+});
+
+export = async () => {
+    const existing = await yandex.mdb.getPostgresqlCluster({ name: clusterName });
+
+    const cluster = new yandex.mdb.PostgresqlClusterV2("db", {
+        name: clusterName,
+        environment: yandex.mdb.Environment.Prestable,
+        networkId: network.id,
+        config: {
+            version: "16",
+            resources: {
+                resourcePresetId: "s2.micro",
+                diskSize: 10,
+                diskTypeId: "network-ssd",
+            },
+        },
+        hosts: {
+            a: { zone: yandex.Zone.RuCentral1A, subnetId: subnet.id },
+        },
+    }, { import: existing.clusterId });
+
+    return { clusterName, clusterId: cluster.id };
+};
@@ -0,0 +1,41 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import * as pulumi from "@pulumi/pulumi";
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+const network = new yandex.vpc.Network("data", {});
+const subnet = new yandex.vpc.Subnet("data", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.30.0.0/24"],
+});
+
+const folderId = new pulumi.Config("yandex").require("folderId");
+const serviceAccount = new yandex.iam.ServiceAccount("data", {});
+new yandex.resourcemanager.FolderIamMember("metastore-agent", {
+    folderId: folderId,
+    role: "managed-metastore.integrationProvider",
+    member: pulumi.interpolate`serviceAccount:${serviceAccount.id}`,
+});
+
+const cluster = new yandex.metastore.Cluster("metastore", {
+    serviceAccountId: serviceAccount.id,
+    subnetIds: [subnet.id],
+    clusterConfig: { resourcePresetId: "c2-m8" },
+});
+
+export const clusterId = cluster.id;
+export const thriftUri = pulumi.interpolate`thrift://${cluster.endpointIp}:9083`;
@@ -0,0 +1,54 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import * as pulumi from "@pulumi/pulumi";
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+const network = new yandex.vpc.Network("data", {});
+const subnet = new yandex.vpc.Subnet("data", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.30.0.0/24"],
+});
+
+const folderId = new pulumi.Config("yandex").require("folderId");
+const serviceAccount = new yandex.iam.ServiceAccount("data", {});
+new yandex.resourcemanager.FolderIamMember("spark-agent", {
+    folderId: folderId,
+    role: "managed-spark.integrationProvider",
+    member: pulumi.interpolate`serviceAccount:${serviceAccount.id}`,
+});
+
+const cluster = new yandex.spark.Cluster("spark", {
+    serviceAccountId: serviceAccount.id,
+    network: {
+        subnetIds: [subnet.id],
+    },
+    config: {
+        resourcePools: {
+            driver: { resourcePresetId: "c2-m8", size: 1 },
+            executor: { resourcePresetId: "c4-m16", size: 2 },
+        },
+        historyServer: { enabled: true },
+        dependencies: {
+            pipPackages: ["pandas==2.2.3"],
+        },
+    },
+});
+
+const found = yandex.spark.getClusterOutput({ id: cluster.id });
+
+export const clusterId = cluster.id;
+export const clusterName = found.name;
@@ -0,0 +1,70 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import * as pulumi from "@pulumi/pulumi";
+import * as yandex from "@airoh-io/pulumi-yandex";
+
+const config = new pulumi.Config();
+const warehousePassword = config.requireSecret("warehousePassword");
+
+const network = new yandex.vpc.Network("data", {});
+const subnet = new yandex.vpc.Subnet("data", {
+    networkId: network.id,
+    zone: yandex.Zone.RuCentral1A,
+    v4CidrBlocks: ["10.30.0.0/24"],
+});
+
+const folderId = new pulumi.Config("yandex").require("folderId");
+const serviceAccount = new yandex.iam.ServiceAccount("data", {});
+new yandex.resourcemanager.FolderIamMember("trino-agent", {
+    folderId: folderId,
+    role: "managed-trino.integrationProvider",
+    member: pulumi.interpolate`serviceAccount:${serviceAccount.id}`,
+});
+
+const cluster = new yandex.trino.Cluster("trino", {
+    serviceAccountId: serviceAccount.id,
+    subnetIds: [subnet.id],
+    coordinator: { resourcePresetId: "c4-m16" },
+    worker: {
+        resourcePresetId: "c4-m16",
+        autoScale: { minCount: 1, maxCount: 3 },
+    },
+});
+
+// The password and the connector properties are stored as secrets.
+const warehouse = new yandex.trino.Catalog("warehouse", {
+    clusterId: cluster.id,
+    postgresql: {
+        connection: {
+            onPremise: {
+                connectionUrl: "jdbc:postgresql://warehouse.example.internal:5432/analytics",
+                userName: "trino",
+                password: warehousePassword,
+            },
+        },
+        additionalProperties: {
+            "postgresql.array-mapping": "AS_ARRAY",
+        },
+    },
+});
+
+const tpch = new yandex.trino.Catalog("tpch", {
+    clusterId: cluster.id,
+    tpch: {},
+});
+
+export const clusterId = cluster.id;
+export const catalogs = [warehouse.name, tpch.name];
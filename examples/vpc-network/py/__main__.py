@@ -3,6 +3,6 @@
 import pulumi
 import pulumi_yandex as yandex
 
-default = yandex.VpcNetwork("default")
+default = yandex.vpc.Network("default")
 
 pulumi.export("network-id", default.id)
\ No newline at end of file
@@ -12,8 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import * as yandex from "@pulumi/yandex";
+import * as yandex from "@airoh-io/pulumi-yandex";
 
-const defaultVpcNetwork = new yandex.VpcNetwork("pulumi-acc-test", {});
+const defaultVpcNetwork = new yandex.vpc.Network("pulumi-acc-test", {});
 
 export const networkId = defaultVpcNetwork.id;
\ No newline at end of file
@@ -0,0 +1,216 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	allowedFolderIdsKey = "allowedFolderIds"
+	allowedCloudIdsKey  = "allowedCloudIds"
+
+	folderIDEnv       = "YC_FOLDER_ID"
+	cloudIDEnv        = "YC_CLOUD_ID"
+	organizationIDEnv = "YC_ORGANIZATION_ID"
+)
+
+// allowedFolderIdsConfig and allowedCloudIdsConfig are the Pulumi-only allow-list provider options.
+var (
+	allowedFolderIdsConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeList,
+			Optional: true,
+			Elem:     (&schema.Schema{Type: shim.TypeString}).Shim(),
+			Description: "Folders the provider may manage resources and run invokes in. A resource whose folderId, " +
+				"explicit or inherited from the provider, is not in the list fails to check.",
+		}).Shim(),
+	}
+	allowedCloudIdsConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeList,
+			Optional: true,
+			Elem:     (&schema.Schema{Type: shim.TypeString}).Shim(),
+			Description: "Clouds the provider may manage resources and run invokes in. A resource whose cloudId, " +
+				"explicit or inherited from the provider, is not in the list fails to check.",
+		}).Shim(),
+	}
+)
+
+// folderScope is the set of folders and clouds the provider is allowed to touch, along with the
+// folder and cloud resources inherit from the provider configuration.
+type folderScope struct {
+	folders, clouds   map[string]bool
+	folderID, cloudID string
+}
+
+// newFolderScope reads the allow-lists from the provider configuration. It returns nil when
+// neither list is set. The inherited folder and cloud fall back to YC_FOLDER_ID and YC_CLOUD_ID,
+// like the folderId and cloudId options themselves.
+func newFolderScope(meta resource.PropertyMap) (*folderScope, error) {
+	folders, err := configStringList(meta, allowedFolderIdsKey)
+	if err != nil {
+		return nil, err
+	}
+	clouds, err := configStringList(meta, allowedCloudIdsKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(folders) == 0 && len(clouds) == 0 {
+		return nil, nil
+	}
+	return &folderScope{
+		folders:  stringSet(folders),
+		clouds:   stringSet(clouds),
+		folderID: configStringOrEnv(meta, "folderId", folderIDEnv),
+		cloudID:  configStringOrEnv(meta, "cloudId", cloudIDEnv),
+	}, nil
+}
+
+// check fails when the folder or cloud is outside its allow-list. Empty IDs are not checked.
+func (s *folderScope) check(folderID, cloudID string) error {
+	if len(s.folders) > 0 && folderID != "" && !s.folders[folderID] {
+		return fmt.Errorf("folder %q is not in yandex:%s (%s); check folderId and YC_FOLDER_ID",
+			folderID, allowedFolderIdsKey, joinSet(s.folders))
+	}
+	if len(s.clouds) > 0 && cloudID != "" && !s.clouds[cloudID] {
+		return fmt.Errorf("cloud %q is not in yandex:%s (%s); check cloudId and YC_CLOUD_ID",
+			cloudID, allowedCloudIdsKey, joinSet(s.clouds))
+	}
+	return nil
+}
+
+// scopedInvoke records which of the folder_id and cloud_id arguments a data source has.
+type scopedInvoke struct {
+	folder, cloud bool
+}
+
+// checkInvoke checks an invoke of the function tok with the given arguments. A data source without
+// a folder_id or cloud_id argument doesn't inherit the provider's one either.
+func (s *folderScope) checkInvoke(tok string, fields scopedInvoke, args resource.PropertyMap) error {
+	var folderID, cloudID string
+	if fields.folder {
+		folderID = effectiveID(args, "folderId", s.folderID)
+	}
+	if fields.cloud {
+		cloudID = effectiveID(args, "cloudId", s.cloudID)
+	}
+	if err := s.check(folderID, cloudID); err != nil {
+		return fmt.Errorf("invoking %s: %w", tok, err)
+	}
+	return nil
+}
+
+// scopedInvokes maps the function tokens of the data sources that take a folder or a cloud
+// argument to the arguments they take.
+func scopedInvokes(prov *tfbridge.ProviderInfo) map[string]scopedInvoke {
+	invokes := map[string]scopedInvoke{}
+	prov.P.DataSourcesMap().Range(func(name string, ds shim.Resource) bool {
+		info := prov.DataSources[name]
+		if info == nil {
+			return true
+		}
+		folder, cloud := isArgument(ds, "folder_id"), isArgument(ds, "cloud_id")
+		if folder || cloud {
+			invokes[string(info.Tok)] = scopedInvoke{folder: folder, cloud: cloud}
+		}
+		return true
+	})
+	return invokes
+}
+
+// applyFolderScope checks the effective folder and cloud of every resource that has one against
+// the allow-lists.
+func applyFolderScope(prov *tfbridge.ProviderInfo) {
+	scoped := map[*tfbridge.ResourceInfo]bool{}
+	for _, field := range []string{"folder_id", "cloud_id"} {
+		eachResourceWith(prov, field, func(_ string, info *tfbridge.ResourceInfo, _ shim.Schema) {
+			if !scoped[info] {
+				scoped[info] = true
+				info.PreCheckCallback = chainPreCheck(info.PreCheckCallback, checkFolderScope)
+			}
+		})
+	}
+}
+
+func checkFolderScope(ctx context.Context, config, meta resource.PropertyMap) (resource.PropertyMap, error) {
+	scope, err := newFolderScope(meta)
+	if err != nil || scope == nil {
+		return config, err
+	}
+	if err := scope.check(effectiveID(config, "folderId", scope.folderID),
+		effectiveID(config, "cloudId", scope.cloudID)); err != nil {
+		return nil, fmt.Errorf("%s: %w", contextURN(ctx, "resource"), err)
+	}
+	return config, nil
+}
+
+// contextURN returns the URN of the resource the bridge is working on, or t when the context
+// carries none.
+func contextURN(ctx context.Context, t string) (name string) {
+	defer func() {
+		if recover() != nil {
+			name = t
+		}
+	}()
+	if urn := tfbridge.GetUrn(ctx); urn != "" {
+		return string(urn)
+	}
+	return t
+}
+
+// effectiveID returns the resource's own ID for key, or the inherited one when the resource
+// doesn't set it. Unknown IDs are not checked.
+func effectiveID(config resource.PropertyMap, key resource.PropertyKey, inherited string) string {
+	v, ok := config[key]
+	switch {
+	case !ok || v.IsNull():
+		return inherited
+	case v.ContainsUnknowns():
+		return ""
+	}
+	return configString(config, key)
+}
+
+// isArgument reports whether field is an input of the data source ds.
+func isArgument(ds shim.Resource, field string) bool {
+	sch, ok := ds.Schema().GetOk(field)
+	return ok && (sch.Optional() || sch.Required())
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func joinSet(set map[string]bool) string {
+	values := make([]string, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ", ")
+}
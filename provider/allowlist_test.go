@@ -0,0 +1,167 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+func TestCheckFolderScope(t *testing.T) {
+	meta := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"folderId":         "b1gdev",
+		"allowedFolderIds": `["b1gprod","b1gstaging"]`,
+		"allowedCloudIds":  []interface{}{"b1gcloud"},
+	})
+
+	tests := []struct {
+		name   string
+		config resource.PropertyMap
+		err    string
+	}{
+		{
+			name:   "explicit allowed folder",
+			config: resource.PropertyMap{"folderId": resource.NewStringProperty("b1gprod")},
+		},
+		{
+			name:   "explicit folder outside the list",
+			config: resource.PropertyMap{"folderId": resource.NewStringProperty("b1gother")},
+			err:    `folder "b1gother" is not in yandex:allowedFolderIds (b1gprod, b1gstaging)`,
+		},
+		{
+			name:   "inherited folder outside the list",
+			config: resource.PropertyMap{"name": resource.NewStringProperty("net")},
+			err:    `folder "b1gdev" is not in yandex:allowedFolderIds`,
+		},
+		{
+			name:   "unknown folder",
+			config: resource.PropertyMap{"folderId": resource.MakeComputed(resource.NewStringProperty(""))},
+		},
+		{
+			name: "cloud outside the list",
+			config: resource.PropertyMap{
+				"folderId": resource.NewStringProperty("b1gprod"),
+				"cloudId":  resource.NewStringProperty("b1gothercloud"),
+			},
+			err: `cloud "b1gothercloud" is not in yandex:allowedCloudIds (b1gcloud)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := checkFolderScope(context.Background(), tt.config, meta)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+				t.Fatalf("got error %v, want it to contain %q", err, tt.err)
+			}
+		})
+	}
+
+	// Without allow-lists nothing is checked.
+	config := resource.PropertyMap{"folderId": resource.NewStringProperty("b1gother")}
+	if _, err := checkFolderScope(context.Background(), config, resource.PropertyMap{}); err != nil {
+		t.Errorf("unexpected error without allow-lists: %v", err)
+	}
+}
+
+func TestFolderScopeEnv(t *testing.T) {
+	t.Setenv(folderIDEnv, "b1genv")
+	t.Setenv(cloudIDEnv, "b1gcloudenv")
+	meta := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"allowedFolderIds": []interface{}{"b1gprod"},
+	})
+
+	_, err := checkFolderScope(context.Background(), resource.PropertyMap{}, meta)
+	if err == nil || !strings.Contains(err.Error(), `folder "b1genv"`) {
+		t.Errorf("got error %v, want the folder from %s to be rejected", err, folderIDEnv)
+	}
+
+	meta["folderId"] = resource.NewStringProperty("b1gprod")
+	if _, err := checkFolderScope(context.Background(), resource.PropertyMap{}, meta); err != nil {
+		t.Errorf("the configured folder should win over %s: %v", folderIDEnv, err)
+	}
+
+	scope, err := newFolderScope(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scope.cloudID != "b1gcloudenv" {
+		t.Errorf("got inherited cloud %q, want the one from %s", scope.cloudID, cloudIDEnv)
+	}
+}
+
+func TestFolderScopeInvokes(t *testing.T) {
+	prov, guard := newProvider()
+	inner := &recordingServer{}
+	s := newGuardedServer(inner, guard, nil)
+	if err := configureServer(t, s, map[string]interface{}{
+		"folderId":         "b1gdev",
+		"allowedFolderIds": []interface{}{"b1gprod"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// yandex_vpc_network is served by the SDKv2 half of the upstream provider and
+	// yandex_mdb_opensearch_cluster by the plugin-framework half.
+	for _, name := range []string{"yandex_vpc_network", "yandex_mdb_opensearch_cluster"} {
+		t.Run(name, func(t *testing.T) {
+			ds, ok := prov.DataSources[name]
+			if !ok {
+				t.Fatalf("data source %q is not mapped", name)
+			}
+			tok := string(ds.Tok)
+			invoke := func(args map[string]interface{}) error {
+				props, err := plugin.MarshalProperties(resource.NewPropertyMapFromMap(args), plugin.MarshalOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = s.Invoke(context.Background(), &pulumirpc.InvokeRequest{Tok: tok, Args: props})
+				return err
+			}
+
+			inner.calls = nil
+			if err := invoke(map[string]interface{}{"name": "n", "folderId": "b1gprod"}); err != nil {
+				t.Errorf("invoke in an allowed folder failed: %v", err)
+			}
+			err := invoke(map[string]interface{}{"name": "n"})
+			if err == nil || !strings.Contains(err.Error(), "invoking "+tok+`: folder "b1gdev"`) {
+				t.Errorf("got error %v, want the inherited folder to be rejected", err)
+			}
+			err = invoke(map[string]interface{}{"name": "n", "folderId": "b1gother"})
+			if err == nil || !strings.Contains(err.Error(), `folder "b1gother"`) {
+				t.Errorf("got error %v, want the explicit folder to be rejected", err)
+			}
+			if len(inner.calls) != 1 {
+				t.Errorf("%d invokes reached the provider, want only the allowed one", len(inner.calls))
+			}
+		})
+	}
+
+	// Data sources without a folder don't inherit the provider's one.
+	props, err := plugin.MarshalProperties(resource.PropertyMap{}, plugin.MarshalOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tok := string(prov.DataSources["yandex_iam_role"].Tok)
+	if _, err := s.Invoke(context.Background(), &pulumirpc.InvokeRequest{Tok: tok, Args: props}); err != nil {
+		t.Errorf("invoke of %s failed: %v", tok, err)
+	}
+}
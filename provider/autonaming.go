@@ -0,0 +1,164 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+)
+
+// autonameRandLen is the length of the random suffix appended to auto-generated names.
+const autonameRandLen = 7
+
+// namingRule describes the names a Yandex Cloud API accepts for one kind of object.
+type namingRule struct {
+	maxLen      int
+	separator   string
+	lower       bool           // names are lowercase only
+	invalid     *regexp.Regexp // runs of characters that are replaced with the separator
+	startLetter bool           // names must start with a letter
+	startAlnum  bool           // names must start with a letter or a digit
+}
+
+var (
+	// cloudNaming covers most resources: a lowercase letter followed by lowercase letters,
+	// digits and hyphens, at most 63 characters.
+	cloudNaming = namingRule{
+		maxLen: 63, separator: "-", lower: true, startLetter: true,
+		invalid: regexp.MustCompile(`[^a-z0-9-]+`),
+	}
+	// bucketNaming follows the Object Storage bucket rules.
+	bucketNaming = namingRule{
+		maxLen: 63, separator: "-", lower: true, startAlnum: true,
+		invalid: regexp.MustCompile(`[^a-z0-9.-]+`),
+	}
+	// sqlNaming covers databases and users of the managed database engines.
+	sqlNaming = namingRule{
+		maxLen: 63, separator: "_", lower: true, startLetter: true,
+		invalid: regexp.MustCompile(`[^a-z0-9_]+`),
+	}
+	// mysqlUserNaming is sqlNaming with the shorter MySQL user name limit.
+	mysqlUserNaming = namingRule{
+		maxLen: 32, separator: "_", lower: true, startLetter: true,
+		invalid: regexp.MustCompile(`[^a-z0-9_]+`),
+	}
+	// kafkaTopicNaming follows the Kafka topic name rules.
+	kafkaTopicNaming = namingRule{
+		maxLen: 249, separator: "-",
+		invalid: regexp.MustCompile(`[^a-zA-Z0-9._-]+`),
+	}
+	// ydbNaming covers YDB tables, topics and their children.
+	ydbNaming = namingRule{
+		maxLen: 255, separator: "_", startLetter: true,
+		invalid: regexp.MustCompile(`[^a-zA-Z0-9._-]+`),
+	}
+)
+
+// autonamedFields lists resources whose auto-named field or naming rule differs from cloudNaming
+// on "name".
+var autonamedFields = map[string]map[string]namingRule{
+	"yandex_mdb_clickhouse_database": {"name": sqlNaming},
+	"yandex_mdb_clickhouse_user":     {"name": sqlNaming},
+	"yandex_mdb_kafka_topic":         {"name": kafkaTopicNaming},
+	"yandex_mdb_kafka_user":          {"name": sqlNaming},
+	"yandex_mdb_mongodb_database":    {"name": sqlNaming},
+	"yandex_mdb_mongodb_user":        {"name": sqlNaming},
+	"yandex_mdb_mysql_database":      {"name": sqlNaming},
+	"yandex_mdb_mysql_user":          {"name": mysqlUserNaming},
+	"yandex_mdb_postgresql_database": {"name": sqlNaming},
+	"yandex_mdb_postgresql_user":     {"name": sqlNaming},
+	"yandex_storage_bucket":          {"bucket": bucketNaming},
+	"yandex_ydb_table":               {"path": ydbNaming},
+	"yandex_ydb_table_changefeed":    {"name": ydbNaming},
+	"yandex_ydb_table_index":         {"name": ydbNaming},
+	"yandex_ydb_topic":               {"name": ydbNaming},
+}
+
+// notAutonamed lists resources whose "name" is not a free-form object name and must not be
+// generated.
+var notAutonamed = map[string]bool{
+	"yandex_container_repository": true, // <registry id>/<name>
+	"yandex_dns_recordset":        true, // record name within the zone
+}
+
+// transform turns a Pulumi resource name into a name prefix valid under the rule.
+func (r namingRule) transform(name string) string {
+	if r.lower {
+		name = strings.ToLower(name)
+	}
+	name = r.invalid.ReplaceAllString(name, r.separator)
+	if r.startLetter || r.startAlnum {
+		name = strings.TrimLeftFunc(name, func(c rune) bool {
+			return !unicode.IsLetter(c) && (r.startLetter || !unicode.IsDigit(c))
+		})
+		if name == "" {
+			name = "r"
+		}
+	}
+	// Leave room for the separator and random suffix so long resource names still fit.
+	if limit := r.maxLen - len(r.separator) - autonameRandLen; len(name) > limit {
+		name = name[:limit]
+	}
+	return strings.TrimRight(name, r.separator)
+}
+
+// generated reports whether name looks like one the rule generates for the Pulumi resource name
+// resourceName: its transformed prefix, the separator and a random suffix.
+func (r namingRule) generated(resourceName, name string) bool {
+	prefix := r.transform(resourceName) + r.separator
+	return strings.HasPrefix(name, prefix) && len(name) == len(prefix)+autonameRandLen
+}
+
+func (r namingRule) autoName(field string) *tfbridge.SchemaInfo {
+	return tfbridge.AutoNameWithCustomOptions(field, tfbridge.AutoNameOptions{
+		Separator: r.separator,
+		Maxlen:    r.maxLen,
+		Randlen:   autonameRandLen,
+		Transform: r.transform,
+	})
+}
+
+// applyAutonaming auto-names every resource according to the naming rule of its service. Only
+// string inputs are auto-named, and fields that already have a SchemaInfo are left alone.
+func applyAutonaming(prov *tfbridge.ProviderInfo) {
+	prov.P.ResourcesMap().Range(func(name string, res shim.Resource) bool {
+		info := prov.Resources[name]
+		if info == nil {
+			return true
+		}
+		fields, ok := autonamedFields[name]
+		if !ok && !notAutonamed[name] {
+			fields = map[string]namingRule{"name": cloudNaming}
+		}
+		for field, rule := range fields {
+			sch, ok := res.Schema().GetOk(field)
+			if !ok || sch.Type() != shim.TypeString || !(sch.Optional() || sch.Required()) {
+				continue
+			}
+			if _, ok := info.Fields[field]; ok {
+				continue
+			}
+			if info.Fields == nil {
+				info.Fields = map[string]*tfbridge.SchemaInfo{}
+			}
+			info.Fields[field] = rule.autoName(field)
+		}
+		return true
+	})
+}
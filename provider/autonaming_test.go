@@ -0,0 +1,89 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestNamingRuleTransform(t *testing.T) {
+	tests := []struct {
+		rule namingRule
+		in   string
+		want string
+	}{
+		{cloudNaming, "web", "web"},
+		{cloudNaming, "My_App.Server", "my-app-server"},
+		{cloudNaming, "1st-node", "st-node"},
+		{cloudNaming, "123", "r"},
+		{sqlNaming, "orders-db", "orders_db"},
+		{kafkaTopicNaming, "Orders.Events", "Orders.Events"},
+		{bucketNaming, "2024.Logs", "2024.logs"},
+		{bucketNaming, ".logs", "logs"},
+		{bucketNaming, "-_Site.Assets", "site.assets"},
+		{bucketNaming, "..", "r"},
+	}
+	for _, tt := range tests {
+		if got := tt.rule.transform(tt.in); got != tt.want {
+			t.Errorf("transform(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAutonaming(t *testing.T) {
+	prov := Provider()
+
+	tests := []struct {
+		resource string
+		field    string
+		urnName  string
+		pattern  string
+	}{
+		{"yandex_compute_instance", "name", "Web_Server", `^web-server-[a-z0-9]{7}$`},
+		{"yandex_vpc_network", "name", "a-very-long-network-name-that-does-not-fit-into-sixty-three-characters", `^[a-z][a-z0-9-]{0,62}$`},
+		{"yandex_mdb_postgresql_database", "name", "orders-db", `^orders_db_[a-z0-9]{7}$`},
+		{"yandex_mdb_mysql_user", "name", "reporting-service-account-user", `^[a-z][a-z0-9_]{0,31}$`},
+		{"yandex_storage_bucket", "bucket", "Assets", `^assets-[a-z0-9]{7}$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			info := prov.Resources[tt.resource].Fields[tt.field]
+			if info == nil || info.Default == nil || !info.Default.AutoNamed {
+				t.Fatalf("%s.%s is not auto-named", tt.resource, tt.field)
+			}
+			urn := resource.NewURN("dev", "proj", "", tokens.Type(prov.Resources[tt.resource].Tok), tt.urnName)
+			v, err := info.Default.ComputeDefault(context.Background(), tfbridge.ComputeDefaultOptions{
+				URN:  urn,
+				Seed: []byte("seed"),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !regexp.MustCompile(tt.pattern).MatchString(v.(string)) {
+				t.Errorf("auto-name %q does not match %s", v, tt.pattern)
+			}
+		})
+	}
+
+	if f := prov.Resources["yandex_dns_recordset"].Fields["name"]; f != nil && f.Default != nil {
+		t.Errorf("yandex_dns_recordset name must not be auto-named")
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/airoh-io/pulumi-yandex/provider/pkg/cliconfig"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	rprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// profileOptions are the provider options a yc CLI profile can fill in, with the environment
+// variables that back them.
+var profileOptions = []struct {
+	key    resource.PropertyKey
+	env    string
+	secret bool
+}{
+	{key: tokenCredential.key, env: tokenCredential.env, secret: true},
+	{key: serviceAccountCredential.key, env: serviceAccountCredential.env, secret: true},
+	{key: "cloudId", env: "YC_CLOUD_ID"},
+	{key: "folderId", env: folderIDEnv},
+	{key: "organizationId", env: organizationIDEnv},
+	{key: "zone", env: zoneEnv},
+	{key: "endpoint", env: "YC_ENDPOINT"},
+}
+
+// applyCLIProfile returns a PreConfigureCallbackWithLogger that falls back to the active yc CLI
+// profile in the config file at path for options set neither in the stack configuration nor in
+// the environment. The profile's credentials are only used when no credential is configured some
+// other way, so they never conflict with one. A broken yc config doesn't stop an otherwise
+// configured provider; it is reported as a warning.
+func applyCLIProfile(path string) preConfigureCallback {
+	return func(ctx context.Context, _ *rprovider.HostClient, vars resource.PropertyMap, _ shim.ResourceConfig) error {
+		if path == "" {
+			return nil
+		}
+		profile, err := cliconfig.Load(path)
+		var env map[string]string
+		if err == nil && profile != nil {
+			env, err = profile.Env()
+		}
+		if err != nil {
+			tfbridge.GetLogger(ctx).Warn(fmt.Sprintf("ignoring yc CLI profile: %v", err))
+			return nil
+		}
+
+		for _, c := range credentialSources {
+			if c.configured(vars) || os.Getenv(c.env) != "" {
+				delete(env, tokenCredential.env)
+				delete(env, serviceAccountCredential.env)
+				break
+			}
+		}
+		for _, o := range profileOptions {
+			value, ok := env[o.env]
+			if !ok || configStringOrEnv(vars, o.key, o.env) != "" {
+				continue
+			}
+			v := resource.NewStringProperty(value)
+			if o.secret {
+				v = resource.MakeSecret(v)
+			}
+			vars[o.key] = v
+		}
+		return nil
+	}
+}
+
+// cliProfilePath returns the location of the yc CLI config, or "" when it cannot be determined.
+func cliProfilePath() string {
+	path, err := cliconfig.DefaultPath()
+	if err != nil {
+		return ""
+	}
+	return path
+}
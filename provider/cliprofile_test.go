@@ -0,0 +1,138 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/unstable/testutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// testProfile is a yc CLI config whose active profile has a service account key.
+var testProfile = filepath.Join("pkg", "cliconfig", "testdata", "config.yaml")
+
+// testLoggingContext returns a context tfbridge.GetLogger reports to sink, or discards the messages
+// when sink is nil.
+func testLoggingContext(t *testing.T, sink testutil.LoggingSink) context.Context {
+	return testutil.InitLogging(t, context.Background(), sink)
+}
+
+// unsetProviderEnv clears the environment variables that back the profile and credential options.
+func unsetProviderEnv(t *testing.T) {
+	t.Helper()
+	for _, o := range profileOptions {
+		t.Setenv(o.env, "")
+		os.Unsetenv(o.env)
+	}
+	for _, c := range credentialSources {
+		t.Setenv(c.env, "")
+		os.Unsetenv(c.env)
+	}
+}
+
+func TestCLIProfileFallback(t *testing.T) {
+	unsetProviderEnv(t)
+	t.Setenv("YC_CLOUD_ID", "b1genvcloud")
+
+	vars := resource.PropertyMap{"folderId": resource.NewStringProperty("b1gexplicit")}
+	if err := applyCLIProfile(testProfile)(testLoggingContext(t, nil), nil, vars, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := configString(vars, "folderId"); got != "b1gexplicit" {
+		t.Errorf("folderId was overridden: %q", got)
+	}
+	if _, ok := vars["cloudId"]; ok {
+		t.Errorf("cloudId was set although YC_CLOUD_ID is: %v", vars["cloudId"])
+	}
+	if got := configString(vars, "zone"); got != "ru-central1-b" {
+		t.Errorf("zone: got %q", got)
+	}
+	if key := vars["serviceAccountKeyFile"]; !key.IsSecret() {
+		t.Errorf("serviceAccountKeyFile: got %v, want the profile key as a secret", key)
+	}
+}
+
+func TestCLIProfileKeepsConfiguredCredential(t *testing.T) {
+	tests := []struct {
+		name string
+		vars resource.PropertyMap
+		env  map[string]string
+	}{
+		{
+			name: "config token",
+			vars: resource.PropertyMap{"token": resource.MakeSecret(resource.NewStringProperty("t1.explicit"))},
+		},
+		{
+			name: "config key file",
+			vars: resource.PropertyMap{"serviceAccountKeyFile": resource.NewStringProperty("/keys/sa.json")},
+		},
+		{
+			name: "environment token",
+			vars: resource.PropertyMap{},
+			env:  map[string]string{"YC_TOKEN": "t1.explicit"},
+		},
+		{
+			name: "oidc",
+			vars: resource.PropertyMap{"oidcTokenEnv": resource.NewStringProperty("CI_JOB_JWT")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetProviderEnv(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			before := tt.vars.Copy()
+			if err := applyCLIProfile(testProfile)(testLoggingContext(t, nil), nil, tt.vars, nil); err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range []resource.PropertyKey{"token", "serviceAccountKeyFile"} {
+				if !tt.vars[c].DeepEquals(before[c]) {
+					t.Errorf("%s: got %v, want %v", c, tt.vars[c], before[c])
+				}
+			}
+			if got := configString(tt.vars, "folderId"); got != "b1gdevfolder" {
+				t.Errorf("folderId: got %q, want the profile folder", got)
+			}
+		})
+	}
+}
+
+func TestCLIProfileBrokenConfig(t *testing.T) {
+	unsetProviderEnv(t)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("current: [dev\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var logger recordingLogger
+	vars := resource.PropertyMap{}
+	if err := applyCLIProfile(path)(testLoggingContext(t, &logger), nil, vars, nil); err != nil {
+		t.Fatalf("a broken yc config failed the configuration: %v", err)
+	}
+	if len(vars) != 0 {
+		t.Errorf("got options %v from a broken yc config", vars)
+	}
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "ignoring yc CLI profile") {
+		t.Errorf("got messages %q, want a warning about the yc config", logger.messages)
+	}
+}
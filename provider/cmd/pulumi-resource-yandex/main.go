@@ -17,12 +17,23 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+
 	yandex "github.com/airoh-io/pulumi-yandex/provider"
-	"github.com/airoh-io/pulumi-yandex/provider/pkg/version"
-	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	"github.com/airoh-io/pulumi-yandex/provider/pkg/tracing"
 )
 
 func main() {
-	// Modify the path to point to the new provider
-	tfbridge.Main("yandex", version.Version, yandex.Provider(), pulumiSchema)
+	// Tracing is opt-in through the environment and must not stop the provider from serving.
+	ctx := context.Background()
+	shutdown, err := tracing.Start(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: tracing: %v\n", err)
+	}
+	defer func() { _ = shutdown(ctx) }()
+
+	// Serve both the SDKv2 and plugin-framework resources through a single muxed server.
+	yandex.Main(ctx, "yandex", pulumiSchema)
 }
@@ -15,12 +15,12 @@
 package main
 
 import (
-	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfgen"
 	yandex "github.com/airoh-io/pulumi-yandex/provider"
-	"github.com/airoh-io/pulumi-yandex/provider/pkg/version"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/pf/tfgen"
 )
 
 func main() {
-	// Modify the path to point to the new provider
-	tfgen.Main("yandex", version.Version, yandex.Provider())
+	// The provider muxes the SDKv2 and plugin-framework halves of upstream, so schema
+	// generation has to go through the muxing entry point.
+	tfgen.MainWithMuxer("yandex", yandex.Provider())
 }
@@ -0,0 +1,166 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	rprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// credentialSource is a provider option that authenticates API calls on its own.
+type credentialSource struct {
+	key resource.PropertyKey
+	env string
+	alt resource.PropertyKey // another option that configures the same source
+}
+
+func (c credentialSource) String() string {
+	if c.alt != "" {
+		return fmt.Sprintf("yandex:%s (%s) or yandex:%s", c.key, c.env, c.alt)
+	}
+	return fmt.Sprintf("yandex:%s (%s)", c.key, c.env)
+}
+
+// value returns the option from the provider configuration, or else from its environment variable.
+func (c credentialSource) value(vars resource.PropertyMap) string {
+	return configStringOrEnv(vars, c.key, c.env)
+}
+
+func (c credentialSource) configured(vars resource.PropertyMap) bool {
+	return c.value(vars) != "" || (c.alt != "" && configString(vars, c.alt) != "")
+}
+
+var (
+	tokenCredential          = credentialSource{key: "token", env: "YC_TOKEN"}
+	serviceAccountCredential = credentialSource{key: "serviceAccountKeyFile", env: "YC_SERVICE_ACCOUNT_KEY_FILE"}
+	oidcCredential           = credentialSource{key: oidcTokenFileKey, env: oidcTokenFileEnv, alt: oidcTokenEnvKey}
+)
+
+// credentialSources lists the options of which at most one may be configured. With none of them,
+// upstream authenticates as the service account attached to the VM through the instance metadata
+// service.
+var credentialSources = []credentialSource{tokenCredential, serviceAccountCredential, oidcCredential}
+
+// serviceAccountKey is the part of an authorized key file the provider needs to sign IAM token
+// requests.
+type serviceAccountKey struct {
+	ID               string `json:"id"`
+	ServiceAccountID string `json:"service_account_id"`
+	PrivateKey       string `json:"private_key"`
+}
+
+// validateCredentials checks the provider configuration offline, so that conflicting or malformed
+// credentials fail at configuration time instead of inside the first API call. Options are looked
+// up in the environment too, since PreConfigure only sees explicit ones. A provider without a
+// folder, cloud or organization is only warned about: resources that set their own folderId
+// don't need one.
+func validateCredentials(
+	ctx context.Context, _ *rprovider.HostClient, vars resource.PropertyMap, _ shim.ResourceConfig,
+) error {
+	if configStringOrEnv(vars, "folderId", folderIDEnv) == "" &&
+		configStringOrEnv(vars, "cloudId", cloudIDEnv) == "" &&
+		configStringOrEnv(vars, "organizationId", organizationIDEnv) == "" {
+		tfbridge.GetLogger(ctx).Warn(fmt.Sprintf("no folder configured: resources that don't set folderId "+
+			"will fail; set yandex:folderId (%s) or run `yc init`", folderIDEnv))
+	}
+
+	var set []credentialSource
+	for _, c := range credentialSources {
+		if c.configured(vars) {
+			set = append(set, c)
+		}
+	}
+
+	switch len(set) {
+	case 0:
+		// Instance metadata authentication.
+		return nil
+	case 1:
+	default:
+		return checkFailure(string(set[1].key), "only one credential source may be configured, got %s",
+			joinSources(set))
+	}
+
+	if set[0] == serviceAccountCredential {
+		if err := validateServiceAccountKey(serviceAccountCredential.value(vars)); err != nil {
+			return checkFailure(string(serviceAccountCredential.key), "%s: %v", serviceAccountCredential, err)
+		}
+	}
+	if set[0] == oidcCredential {
+		if _, err := newOIDCTokenSource(vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateServiceAccountKey checks a service account key given either as a path to the key file or
+// as the file contents.
+func validateServiceAccountKey(value string) error {
+	contents := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "{") {
+		b, err := os.ReadFile(value)
+		if err != nil {
+			return fmt.Errorf("cannot read key file: %w", err)
+		}
+		contents = b
+	}
+
+	var key serviceAccountKey
+	if err := json.Unmarshal(contents, &key); err != nil {
+		return fmt.Errorf("key is not valid JSON: %w", err)
+	}
+	var missing []string
+	if key.ID == "" {
+		missing = append(missing, `"id"`)
+	}
+	if key.ServiceAccountID == "" {
+		missing = append(missing, `"service_account_id"`)
+	}
+	if key.PrivateKey == "" {
+		missing = append(missing, `"private_key"`)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("key is missing %s; create one with `yc iam key create`", strings.Join(missing, ", "))
+	}
+	if block, _ := pem.Decode([]byte(key.PrivateKey)); block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		return fmt.Errorf(`key "private_key" is not a PEM-encoded private key`)
+	}
+	return nil
+}
+
+func joinSources(sources []credentialSource) string {
+	names := make([]string, len(sources))
+	for i, c := range sources {
+		names[i] = c.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func checkFailure(property, format string, args ...any) error {
+	return tfbridge.CheckFailureError{Failures: []tfbridge.CheckFailureErrorElement{{
+		Property: property,
+		Reason:   fmt.Sprintf(format, args...),
+	}}}
+}
@@ -0,0 +1,218 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// testServiceAccountKey returns the JSON of an authorized key file with a freshly generated key.
+func testServiceAccountKey(t *testing.T, mutate func(map[string]string)) string {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := map[string]string{
+		"id":                 "ajetestkey",
+		"service_account_id": "ajetestsa",
+		"private_key": "PLEASE DO NOT REMOVE THIS LINE! Yandex.Cloud SA Key ID <ajetestkey>\n" +
+			string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+	}
+	if mutate != nil {
+		mutate(key)
+	}
+	b, err := json.Marshal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestValidateCredentials(t *testing.T) {
+	validKey := testServiceAccountKey(t, nil)
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(keyFile, []byte(validKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		vars     map[string]interface{}
+		env      map[string]string
+		property string
+		reason   string // substring of the failure reason, empty when the config is valid
+		warning  string // substring of the warning logged, empty when there is none
+	}{
+		{
+			name: "token",
+			vars: map[string]interface{}{"token": "t1.token"},
+		},
+		{
+			name: "key contents",
+			vars: map[string]interface{}{"serviceAccountKeyFile": validKey},
+		},
+		{
+			name: "key file",
+			vars: map[string]interface{}{"serviceAccountKeyFile": keyFile},
+		},
+		{
+			name: "instance metadata",
+			vars: map[string]interface{}{},
+		},
+		{
+			name: "environment token",
+			env:  map[string]string{"YC_TOKEN": "t1.token"},
+		},
+		{
+			name: "environment key file",
+			env:  map[string]string{"YC_SERVICE_ACCOUNT_KEY_FILE": keyFile},
+		},
+		{
+			name:     "config and environment credentials",
+			vars:     map[string]interface{}{"serviceAccountKeyFile": validKey},
+			env:      map[string]string{"YC_TOKEN": "t1.token"},
+			property: "serviceAccountKeyFile",
+			reason:   "only one credential source",
+		},
+		{
+			name:     "malformed environment key",
+			env:      map[string]string{"YC_SERVICE_ACCOUNT_KEY_FILE": `{"id": `},
+			property: "serviceAccountKeyFile",
+			reason:   "not valid JSON",
+		},
+		{
+			name:    "no folder",
+			vars:    map[string]interface{}{"token": "t1.token"},
+			env:     map[string]string{folderIDEnv: ""},
+			warning: "no folder configured",
+		},
+		{
+			name: "cloud only",
+			vars: map[string]interface{}{"token": "t1.token", "cloudId": "b1gcloud"},
+			env:  map[string]string{folderIDEnv: ""},
+		},
+		{
+			name: "organization only",
+			env:  map[string]string{folderIDEnv: "", organizationIDEnv: "bpforg"},
+		},
+		{
+			name: "config folder",
+			vars: map[string]interface{}{"token": "t1.token", "folderId": "b1gconfig"},
+			env:  map[string]string{folderIDEnv: ""},
+		},
+		{
+			name:     "two credentials",
+			vars:     map[string]interface{}{"token": "t1.token", "serviceAccountKeyFile": validKey},
+			property: "serviceAccountKeyFile",
+			reason:   "only one credential source",
+		},
+		{
+			name:     "missing file",
+			vars:     map[string]interface{}{"serviceAccountKeyFile": filepath.Join(t.TempDir(), "nope.json")},
+			property: "serviceAccountKeyFile",
+			reason:   "YC_SERVICE_ACCOUNT_KEY_FILE): cannot read key file",
+		},
+		{
+			name:     "malformed JSON",
+			vars:     map[string]interface{}{"serviceAccountKeyFile": `{"id": `},
+			property: "serviceAccountKeyFile",
+			reason:   "not valid JSON",
+		},
+		{
+			name: "missing fields",
+			vars: map[string]interface{}{"serviceAccountKeyFile": testServiceAccountKey(t, func(k map[string]string) {
+				delete(k, "id")
+				delete(k, "service_account_id")
+			})},
+			property: "serviceAccountKeyFile",
+			reason:   `missing "id", "service_account_id"`,
+		},
+		{
+			name: "bad private key",
+			vars: map[string]interface{}{"serviceAccountKeyFile": testServiceAccountKey(t, func(k map[string]string) {
+				k["private_key"] = "not a key"
+			})},
+			property: "serviceAccountKeyFile",
+			reason:   "not a PEM-encoded private key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetProviderEnv(t)
+			t.Setenv(folderIDEnv, "b1gfolder")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			vars := resource.NewPropertyMapFromMap(tt.vars)
+			for k, v := range vars {
+				if k == "token" || k == "serviceAccountKeyFile" {
+					vars[k] = resource.MakeSecret(v)
+				}
+			}
+
+			var logger recordingLogger
+			err := validateCredentials(testLoggingContext(t, &logger), nil, vars, nil)
+			if tt.warning == "" && len(logger.messages) != 0 {
+				t.Errorf("unexpected warnings: %q", logger.messages)
+			}
+			if tt.warning != "" && (len(logger.messages) != 1 || !strings.Contains(logger.messages[0], tt.warning)) {
+				t.Errorf("got warnings %q, want one containing %q", logger.messages, tt.warning)
+			}
+			if tt.reason == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var failure tfbridge.CheckFailureError
+			if !errors.As(err, &failure) || len(failure.Failures) != 1 {
+				t.Fatalf("expected a single check failure, got %v", err)
+			}
+			if got := failure.Failures[0]; got.Property != tt.property || !strings.Contains(got.Reason, tt.reason) {
+				t.Errorf("got failure %+v, want property %q and reason containing %q", got, tt.property, tt.reason)
+			}
+		})
+	}
+}
+
+func TestValidateCredentialsFromProfile(t *testing.T) {
+	unsetProviderEnv(t)
+
+	// The profile provides both the folder and the service account key.
+	check := chainPreConfigure(applyCLIProfile(testProfile), validateCredentials)
+	if err := check(testLoggingContext(t, nil), nil, resource.PropertyMap{}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const defaultLabelsKey = "defaultLabels"
+
+// defaultLabelsConfig is the Pulumi-only defaultLabels provider option.
+var defaultLabelsConfig = &tfbridge.ConfigInfo{
+	Schema: (&schema.Schema{
+		Type:        shim.TypeMap,
+		Optional:    true,
+		Elem:        (&schema.Schema{Type: shim.TypeString}).Shim(),
+		Description: "Labels added to every resource that supports labels. Labels set on a resource take precedence.",
+	}).Shim(),
+}
+
+// applyDefaultLabels merges the defaultLabels provider option into every resource whose upstream
+// schema has a labels map. The merged labels become the resource inputs, so the labels the cloud
+// echoes back match them and diffs stay clean.
+func applyDefaultLabels(prov *tfbridge.ProviderInfo) {
+	eachResourceWith(prov, "labels", func(_ string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		if sch.Type() != shim.TypeMap {
+			return
+		}
+		info.PreCheckCallback = chainPreCheck(info.PreCheckCallback, mergeDefaultLabels)
+	})
+}
+
+func mergeDefaultLabels(_ context.Context, config, meta resource.PropertyMap) (resource.PropertyMap, error) {
+	defaults, err := configStringMap(meta, defaultLabelsKey)
+	if err != nil || len(defaults) == 0 {
+		return config, err
+	}
+
+	labels := config["labels"]
+	if labels.IsComputed() || labels.IsOutput() && !labels.OutputValue().Known {
+		// Merging into an unknown map would hide it; the next update picks the defaults up.
+		// Unknown values of single labels are kept as they are below.
+		return config, nil
+	}
+	var secret bool
+	switch {
+	case labels.IsSecret():
+		secret, labels = true, labels.SecretValue().Element
+	case labels.IsOutput():
+		secret, labels = labels.OutputValue().Secret, labels.OutputValue().Element
+	}
+
+	merged := resource.PropertyMap{}
+	for k, v := range defaults {
+		merged[resource.PropertyKey(k)] = resource.NewStringProperty(v)
+	}
+	if labels.IsObject() {
+		for k, v := range labels.ObjectValue() {
+			merged[k] = v
+		}
+	}
+
+	result := config.Copy()
+	result["labels"] = resource.NewObjectProperty(merged)
+	if secret {
+		result["labels"] = resource.MakeSecret(result["labels"])
+	}
+	return result, nil
+}
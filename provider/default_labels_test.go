@@ -0,0 +1,116 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestMergeDefaultLabels(t *testing.T) {
+	meta := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"defaultLabels": map[string]interface{}{"team": "platform", "env": "prod"},
+	})
+	config := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name":   "web",
+		"labels": map[string]interface{}{"env": "staging"},
+	})
+
+	got, err := mergeDefaultLabels(context.Background(), config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := resource.NewPropertyValue(map[string]interface{}{"team": "platform", "env": "staging"})
+	if !got["labels"].DeepEquals(want) {
+		t.Errorf("got labels %v, want %v", got["labels"], want)
+	}
+	if !config["labels"].DeepEquals(resource.NewPropertyValue(map[string]interface{}{"env": "staging"})) {
+		t.Errorf("inputs were modified in place: %v", config["labels"])
+	}
+}
+
+func TestMergeDefaultLabelsFromJSON(t *testing.T) {
+	meta := resource.PropertyMap{
+		"defaultLabels": resource.NewStringProperty(`{"cost-center":"42"}`),
+	}
+
+	got, err := mergeDefaultLabels(context.Background(), resource.PropertyMap{}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := resource.NewPropertyValue(map[string]interface{}{"cost-center": "42"})
+	if !got["labels"].DeepEquals(want) {
+		t.Errorf("got labels %v, want %v", got["labels"], want)
+	}
+}
+
+func TestMergeDefaultLabelsKeepsUnknown(t *testing.T) {
+	meta := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"defaultLabels": map[string]interface{}{"team": "platform"},
+	})
+	config := resource.PropertyMap{
+		"labels": resource.MakeComputed(resource.NewStringProperty("")),
+	}
+
+	got, err := mergeDefaultLabels(context.Background(), config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got["labels"].IsComputed() {
+		t.Errorf("unknown labels were replaced: %v", got["labels"])
+	}
+}
+
+func TestMergeDefaultLabelsWithUnknownValue(t *testing.T) {
+	meta := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"defaultLabels": map[string]interface{}{"team": "platform", "env": "prod"},
+	})
+	unknown := resource.MakeComputed(resource.NewStringProperty(""))
+	config := resource.PropertyMap{
+		"labels": resource.NewObjectProperty(resource.PropertyMap{"env": unknown}),
+	}
+
+	got, err := mergeDefaultLabels(context.Background(), config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := resource.NewObjectProperty(resource.PropertyMap{
+		"team": resource.NewStringProperty("platform"),
+		"env":  unknown,
+	})
+	if !got["labels"].DeepEquals(want) {
+		t.Errorf("got labels %v, want %v", got["labels"], want)
+	}
+}
+
+func TestDefaultLabelsApplied(t *testing.T) {
+	prov := Provider()
+
+	for _, name := range []string{"yandex_compute_instance", "yandex_vpc_network"} {
+		r, ok := prov.Resources[name]
+		if !ok {
+			t.Errorf("resource %q is not mapped", name)
+			continue
+		}
+		if r.PreCheckCallback == nil {
+			t.Errorf("resource %q has labels but no default labels hook", name)
+		}
+	}
+}
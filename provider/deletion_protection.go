@@ -0,0 +1,82 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	defaultDeletionProtectionKey = "defaultDeletionProtection"
+	deletionProtectionField      = "deletion_protection"
+	deletionProtectionKey        = "deletionProtection"
+)
+
+// defaultDeletionProtectionConfig is the Pulumi-only defaultDeletionProtection provider option.
+var defaultDeletionProtectionConfig = &tfbridge.ConfigInfo{
+	Schema: (&schema.Schema{
+		Type:     shim.TypeBool,
+		Optional: true,
+		Description: "Turn on deletionProtection for every resource that supports it, unless the resource sets " +
+			"deletionProtection itself.",
+	}).Shim(),
+}
+
+// applyDefaultDeletionProtection injects the defaultDeletionProtection provider option into
+// every resource whose upstream schema has a deletion_protection flag, whichever half of the
+// upstream provider serves it. Resources that guard against deletion some other way, such as
+// storage buckets, which cannot be deleted while they hold objects unless forceDestroy is set,
+// or have no protection at all, such as compute instances, are left alone.
+func applyDefaultDeletionProtection(prov *tfbridge.ProviderInfo) {
+	eachResourceWith(prov, deletionProtectionField, func(_ string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		if sch.Type() != shim.TypeBool || !(sch.Optional() || sch.Required()) {
+			return
+		}
+		info.PreCheckCallback = chainPreCheck(info.PreCheckCallback, defaultDeletionProtection)
+	})
+}
+
+func defaultDeletionProtection(_ context.Context, config, meta resource.PropertyMap) (resource.PropertyMap, error) {
+	protect, _, err := configBool(meta, defaultDeletionProtectionKey)
+	if err != nil || !protect {
+		return config, err
+	}
+	if v, ok := config[deletionProtectionKey]; ok && !v.IsNull() {
+		return config, nil
+	}
+
+	config = config.Copy()
+	config[deletionProtectionKey] = resource.NewBoolProperty(true)
+	return config, nil
+}
+
+// replacesProtected reports whether a diff that replaces the given properties replaces a resource
+// whose current state olds has deletion protection on. Deleting the old resource would fail, so
+// previews warn about it.
+func replacesProtected(olds resource.PropertyMap, replaces []string) bool {
+	if len(replaces) == 0 {
+		return false
+	}
+	v := olds[deletionProtectionKey]
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	return v.IsBool() && v.BoolValue()
+}
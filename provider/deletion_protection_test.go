@@ -0,0 +1,115 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDefaultDeletionProtection(t *testing.T) {
+	on := resource.PropertyMap{"defaultDeletionProtection": resource.NewBoolProperty(true)}
+	onAsString := resource.PropertyMap{"defaultDeletionProtection": resource.NewStringProperty("true")}
+
+	tests := []struct {
+		name   string
+		config resource.PropertyMap
+		meta   resource.PropertyMap
+		want   resource.PropertyValue
+	}{
+		{"injected", resource.PropertyMap{}, on, resource.NewBoolProperty(true)},
+		{"injected from string", resource.PropertyMap{}, onAsString, resource.NewBoolProperty(true)},
+		{
+			"explicit false wins",
+			resource.PropertyMap{"deletionProtection": resource.NewBoolProperty(false)},
+			on, resource.NewBoolProperty(false),
+		},
+		{"option off", resource.PropertyMap{}, resource.PropertyMap{}, resource.NewNullProperty()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := defaultDeletionProtection(context.Background(), tt.config, tt.meta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v := got["deletionProtection"]; !v.DeepEquals(tt.want) {
+				t.Errorf("got deletionProtection %v, want %v", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultDeletionProtectionApplied(t *testing.T) {
+	prov := Provider()
+	// yandex_mdb_opensearch_cluster comes from the plugin-framework half of the upstream provider.
+	for _, name := range []string{"yandex_mdb_postgresql_cluster", "yandex_mdb_opensearch_cluster"} {
+		info, ok := prov.Resources[name]
+		if !ok {
+			t.Errorf("%s is not mapped", name)
+			continue
+		}
+		if info.PreCheckCallback == nil {
+			t.Errorf("%s has no PreCheckCallback", name)
+		}
+	}
+}
+
+// TestReplacesProtectedWarning replaces a Managed OpenSearch cluster, which the plugin-framework
+// half of the upstream provider serves, through the guarded server.
+func TestReplacesProtectedWarning(t *testing.T) {
+	const urn = "urn:pulumi:prod::infra::yandex:mdb/opensearchCluster:OpensearchCluster::search"
+	state := func(protect bool) *structpb.Struct {
+		olds, err := plugin.MarshalProperties(resource.PropertyMap{
+			"name":                resource.NewStringProperty("search"),
+			deletionProtectionKey: resource.NewBoolProperty(protect),
+		}, plugin.MarshalOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return olds
+	}
+
+	tests := []struct {
+		name     string
+		olds     *structpb.Struct
+		replaces []string
+		warn     bool
+	}{
+		{name: "protected", olds: state(true), replaces: []string{"name"}, warn: true},
+		{name: "in-place update", olds: state(true)},
+		{name: "unprotected", olds: state(false), replaces: []string{"name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			s := newGuardedServer(&recordingServer{replaces: tt.replaces}, &providerGuard{}, logger)
+			resp, err := s.Diff(context.Background(), &pulumirpc.DiffRequest{Urn: urn, Id: "c9q1", Olds: tt.olds})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.GetReplaces()) != len(tt.replaces) {
+				t.Errorf("got replaces %v, want %v", resp.GetReplaces(), tt.replaces)
+			}
+			if warned := len(logger.messages) != 0; warned != tt.warn {
+				t.Errorf("got warnings %q, want a warning: %v", logger.messages, tt.warn)
+			}
+		})
+	}
+}
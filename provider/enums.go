@@ -0,0 +1,149 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"strings"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	pschema "github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// enumType describes a string enum added to the schema. Properties typed with it still accept
+// any string, so values the API learns later don't need a provider release.
+type enumType struct {
+	tok         tokens.Type
+	description string
+	values      []string
+}
+
+var (
+	zoneEnum = enumType{
+		tok:         makeResource(mainMod, "Zone"),
+		description: "Availability zone.",
+		values:      []string{"ru-central1-a", "ru-central1-b", "ru-central1-d", "kz1-a"},
+	}
+	platformEnum = enumType{
+		tok:         makeResource(computeMod, "Platform"),
+		description: "Compute platform of a virtual machine.",
+		values: []string{
+			"standard-v1", "standard-v2", "standard-v3", "highfreq-v3",
+			"gpu-standard-v1", "gpu-standard-v2", "gpu-standard-v3", "standard-v3-t4",
+		},
+	}
+	diskTypeEnum = enumType{
+		tok:         makeResource(computeMod, "DiskType"),
+		description: "Type of a compute disk.",
+		values:      []string{"network-hdd", "network-ssd", "network-ssd-nonreplicated", "network-ssd-io-m3"},
+	}
+	mdbEnvironmentEnum = enumType{
+		tok:         makeResource(mdbMod, "Environment"),
+		description: "Deployment environment of a managed database cluster.",
+		values:      []string{"PRODUCTION", "PRESTABLE"},
+	}
+	opensearchRoleEnum = enumType{
+		tok:         makeResource(mdbMod, "OpensearchNodeGroupRole"),
+		description: "Role of the hosts of an OpenSearch node group.",
+		values:      []string{"DATA", "MANAGER"},
+	}
+	storageClassEnum = enumType{
+		tok:         makeResource(storageMod, "StorageClass"),
+		description: "Object Storage class.",
+		values:      []string{"STANDARD", "COLD", "ICE"},
+	}
+)
+
+var enums = []enumType{
+	zoneEnum, platformEnum, diskTypeEnum, mdbEnvironmentEnum, opensearchRoleEnum, storageClassEnum,
+}
+
+// spec returns the schema type of the enum. Member names are the values in upper camel case, so
+// "ru-central1-a" becomes Zone.RuCentral1A.
+func (e enumType) spec() pschema.ComplexTypeSpec {
+	values := make([]pschema.EnumValueSpec, len(e.values))
+	for i, v := range e.values {
+		values[i] = pschema.EnumValueSpec{Name: upperCamel(strings.ReplaceAll(strings.ToLower(v), "-", "_")), Value: v}
+	}
+	return pschema.ComplexTypeSpec{
+		ObjectTypeSpec: pschema.ObjectTypeSpec{Type: "string", Description: e.description},
+		Enum:           values,
+	}
+}
+
+// enumTypes returns the enums for ProviderInfo.ExtraTypes.
+func enumTypes() map[string]pschema.ComplexTypeSpec {
+	types := make(map[string]pschema.ComplexTypeSpec, len(enums))
+	for _, e := range enums {
+		types[string(e.tok)] = e.spec()
+	}
+	return types
+}
+
+// applyEnums types the well-known string fields with their enums. Resources are matched by the
+// upstream field name and, where the name is ambiguous, by resource.
+func applyEnums(prov *tfbridge.ProviderInfo) {
+	eachResourceWith(prov, "zone", func(_ string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		useEnum(info, "zone", sch, zoneEnum)
+	})
+	eachResourceWith(prov, "platform_id", func(_ string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		useEnum(info, "platform_id", sch, platformEnum)
+	})
+	eachResourceWith(prov, "environment", func(name string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		if strings.HasPrefix(name, "yandex_mdb_") {
+			useEnum(info, "environment", sch, mdbEnvironmentEnum)
+		}
+	})
+	eachResourceWith(prov, "type", func(name string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		if name == "yandex_compute_disk" {
+			useEnum(info, "type", sch, diskTypeEnum)
+		}
+	})
+	eachResourceWith(prov, "default_storage_class", func(name string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		if name == "yandex_storage_bucket" {
+			useEnum(info, "default_storage_class", sch, storageClassEnum)
+		}
+	})
+	// Node groups are nested blocks, so the enums of their list fields are set by path.
+	if info := prov.Resources["yandex_mdb_opensearch_cluster"]; info != nil {
+		info.Fields = useElemEnum(info.Fields, "config.opensearch.node_groups.roles", opensearchRoleEnum)
+		info.Fields = useElemEnum(info.Fields, "config.opensearch.node_groups.zone_ids", zoneEnum)
+		info.Fields = useElemEnum(info.Fields, "config.dashboards.node_groups.zone_ids", zoneEnum)
+	}
+}
+
+func useEnum(info *tfbridge.ResourceInfo, field string, sch shim.Schema, e enumType) {
+	if sch.Type() != shim.TypeString || !(sch.Optional() || sch.Required()) {
+		return
+	}
+	if info.Fields == nil {
+		info.Fields = map[string]*tfbridge.SchemaInfo{}
+	}
+	f := info.Fields[field]
+	if f == nil {
+		f = &tfbridge.SchemaInfo{}
+		info.Fields[field] = f
+	}
+	f.Type = "string"
+	f.AltTypes = []tokens.Type{e.tok}
+}
+
+// useElemEnum types the elements of the list or set field at a dotted path with an enum.
+func useElemEnum(fields map[string]*tfbridge.SchemaInfo, path string, e enumType) map[string]*tfbridge.SchemaInfo {
+	fields, f := fieldAt(fields, path)
+	f.Elem = &tfbridge.SchemaInfo{Type: "string", AltTypes: []tokens.Type{e.tok}}
+	return fields
+}
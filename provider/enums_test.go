@@ -0,0 +1,93 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestEnumTypes(t *testing.T) {
+	types := enumTypes()
+
+	tests := []struct {
+		tok   tokens.Type
+		name  string
+		value string
+	}{
+		{"yandex:index/zone:Zone", "RuCentral1A", "ru-central1-a"},
+		{"yandex:compute/platform:Platform", "StandardV3", "standard-v3"},
+		{"yandex:compute/diskType:DiskType", "NetworkSsd", "network-ssd"},
+		{"yandex:mdb/environment:Environment", "Production", "PRODUCTION"},
+		{"yandex:mdb/opensearchNodeGroupRole:OpensearchNodeGroupRole", "Manager", "MANAGER"},
+		{"yandex:storage/storageClass:StorageClass", "Cold", "COLD"},
+	}
+	for _, tt := range tests {
+		spec, ok := types[string(tt.tok)]
+		if !ok {
+			t.Errorf("missing enum %s", tt.tok)
+			continue
+		}
+		found := false
+		for _, v := range spec.Enum {
+			if v.Name == tt.name && v.Value == tt.value {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("enum %s has no member %s = %q", tt.tok, tt.name, tt.value)
+		}
+	}
+}
+
+func TestEnumFields(t *testing.T) {
+	prov := Provider()
+
+	tests := []struct {
+		resource string
+		field    string
+		enum     enumType
+	}{
+		{"yandex_compute_instance", "zone", zoneEnum},
+		{"yandex_compute_instance", "platform_id", platformEnum},
+		{"yandex_compute_disk", "type", diskTypeEnum},
+		{"yandex_vpc_subnet", "zone", zoneEnum},
+		{"yandex_mdb_postgresql_cluster", "environment", mdbEnvironmentEnum},
+		{"yandex_storage_bucket", "default_storage_class", storageClassEnum},
+	}
+	for _, tt := range tests {
+		f := prov.Resources[tt.resource].Fields[tt.field]
+		if f == nil || f.Type != "string" || len(f.AltTypes) != 1 || f.AltTypes[0] != tt.enum.tok {
+			t.Errorf("%s.%s is not typed with %s: %+v", tt.resource, tt.field, tt.enum.tok, f)
+		}
+	}
+
+	nested := []struct {
+		resource string
+		path     string
+		enum     enumType
+	}{
+		{"yandex_mdb_opensearch_cluster", "config.opensearch.node_groups.roles", opensearchRoleEnum},
+		{"yandex_mdb_opensearch_cluster", "config.opensearch.node_groups.zone_ids", zoneEnum},
+		{"yandex_mdb_opensearch_cluster", "config.dashboards.node_groups.zone_ids", zoneEnum},
+	}
+	for _, tt := range nested {
+		_, f := fieldAt(prov.Resources[tt.resource].Fields, tt.path)
+		if e := f.Elem; e == nil || e.Type != "string" || len(e.AltTypes) != 1 || e.AltTypes[0] != tt.enum.tok {
+			t.Errorf("elements of %s.%s are not typed with %s: %+v", tt.resource, tt.path, tt.enum.tok, e)
+		}
+	}
+}
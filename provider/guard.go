@@ -0,0 +1,83 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"sync/atomic"
+
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	rprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// providerGuard holds the provider options that restrict what the provider may touch. They are
+// set from the provider configuration by guardedServer.Configure, before the upstream provider is
+// configured.
+type providerGuard struct {
+	readOnly atomic.Bool
+	scope    atomic.Pointer[folderScope]
+	throttle atomic.Pointer[throttle]
+	oidc     atomic.Pointer[oidcTokenSource]
+
+	// invokes lists the functions checked against the folder scope; see scopedInvokes.
+	invokes map[string]scopedInvoke
+	// autonamed lists the plugin-framework resources whose replacement is delete-before-create
+	// only when it keeps an explicitly set name; see applyDeleteBeforeReplace.
+	autonamed map[string]autonamedField
+}
+
+// guardSettings are the guard options of one provider configuration.
+type guardSettings struct {
+	readOnly bool
+	scope    *folderScope
+	throttle *throttle
+	oidc     *oidcTokenSource
+}
+
+func newGuardSettings(vars resource.PropertyMap) (s guardSettings, err error) {
+	if s.readOnly, err = readOnlyEnabled(vars); err != nil {
+		return s, err
+	}
+	if s.scope, err = newFolderScope(vars); err != nil {
+		return s, err
+	}
+	if s.throttle, err = newThrottle(vars); err != nil {
+		return s, err
+	}
+	s.oidc, err = newOIDCTokenSource(vars)
+	return s, err
+}
+
+// checkGuardSettings is a PreConfigureCallback that reports malformed guard options as check
+// failures, before the provider is configured with them.
+func checkGuardSettings(_ context.Context, _ *rprovider.HostClient, vars resource.PropertyMap, _ shim.ResourceConfig) error {
+	_, err := newGuardSettings(vars)
+	return err
+}
+
+// configure applies the guard options of the configuration the provider is about to be
+// configured with.
+func (g *providerGuard) configure(vars resource.PropertyMap) error {
+	s, err := newGuardSettings(vars)
+	if err != nil {
+		return err
+	}
+	g.readOnly.Store(s.readOnly)
+	g.scope.Store(s.scope)
+	g.throttle.Store(s.throttle)
+	g.oidc.Store(s.oidc)
+	return nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	rprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// chainPreCheck runs next after any PreCheckCallback the resource already has.
+func chainPreCheck(prev, next tfbridge.PreCheckCallback) tfbridge.PreCheckCallback {
+	if prev == nil {
+		return next
+	}
+	return func(ctx context.Context, config, meta resource.PropertyMap) (resource.PropertyMap, error) {
+		config, err := prev(ctx, config, meta)
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, config, meta)
+	}
+}
+
+// preConfigureCallback is the type of ProviderInfo.PreConfigureCallbackWithLogger. The callbacks
+// take the context so they can report warnings through tfbridge.GetLogger.
+type preConfigureCallback = func(
+	ctx context.Context, host *rprovider.HostClient, vars resource.PropertyMap, config shim.ResourceConfig,
+) error
+
+// chainPreConfigure runs the callbacks in order, stopping at the first error.
+func chainPreConfigure(callbacks ...preConfigureCallback) preConfigureCallback {
+	return func(
+		ctx context.Context, host *rprovider.HostClient, vars resource.PropertyMap, config shim.ResourceConfig,
+	) error {
+		for _, cb := range callbacks {
+			if err := cb(ctx, host, vars, config); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// eachResourceWith calls f for every mapped resource whose upstream schema has the given field.
+func eachResourceWith(prov *tfbridge.ProviderInfo, field string, f func(name string, info *tfbridge.ResourceInfo, sch shim.Schema)) {
+	prov.P.ResourcesMap().Range(func(name string, res shim.Resource) bool {
+		info := prov.Resources[name]
+		if info == nil {
+			return true
+		}
+		if sch, ok := res.Schema().GetOk(field); ok {
+			f(name, info, sch)
+		}
+		return true
+	})
+}
+
+// configStringMap reads a map-valued provider option. Options that are not part of the upstream
+// schema may reach the provider either as an object or as its JSON encoding.
+func configStringMap(meta resource.PropertyMap, key resource.PropertyKey) (map[string]string, error) {
+	v, ok := meta[key]
+	if !ok || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+
+	result := map[string]string{}
+	switch {
+	case v.IsString():
+		if v.StringValue() == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v.StringValue()), &result); err != nil {
+			return nil, fmt.Errorf("provider option %s must be a map of strings: %w", key, err)
+		}
+	case v.IsObject():
+		for k, e := range v.ObjectValue() {
+			if !e.IsString() {
+				return nil, fmt.Errorf("provider option %s must be a map of strings, %s is %s", key, k, e.TypeString())
+			}
+			result[string(k)] = e.StringValue()
+		}
+	default:
+		return nil, fmt.Errorf("provider option %s must be a map of strings, got %s", key, v.TypeString())
+	}
+	return result, nil
+}
+
+// configString reads a string-valued provider option, looking through secrets.
+func configString(meta resource.PropertyMap, key resource.PropertyKey) string {
+	v, ok := meta[key]
+	if !ok {
+		return ""
+	}
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	if !v.IsString() {
+		return ""
+	}
+	return v.StringValue()
+}
+
+// configStringOrEnv reads a string-valued provider option, falling back to the environment
+// variable that backs it. PreConfigure and PreCheck only see options set in the configuration.
+func configStringOrEnv(meta resource.PropertyMap, key resource.PropertyKey, env string) string {
+	if v := configString(meta, key); v != "" {
+		return v
+	}
+	return os.Getenv(env)
+}
+
+// configStringList reads a list-valued provider option. Like configStringMap, it accepts both
+// the list and its JSON encoding.
+func configStringList(meta resource.PropertyMap, key resource.PropertyKey) ([]string, error) {
+	v, ok := meta[key]
+	if !ok || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+
+	var result []string
+	switch {
+	case v.IsString():
+		if v.StringValue() == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v.StringValue()), &result); err != nil {
+			return nil, fmt.Errorf("provider option %s must be a list of strings: %w", key, err)
+		}
+	case v.IsArray():
+		for i, e := range v.ArrayValue() {
+			if !e.IsString() {
+				return nil, fmt.Errorf("provider option %s must be a list of strings, element %d is %s",
+					key, i, e.TypeString())
+			}
+			result = append(result, e.StringValue())
+		}
+	default:
+		return nil, fmt.Errorf("provider option %s must be a list of strings, got %s", key, v.TypeString())
+	}
+	return result, nil
+}
+
+// configBool reads a boolean provider option, which may also arrive as its string form. set
+// reports whether the option was given at all.
+func configBool(meta resource.PropertyMap, key resource.PropertyKey) (value, set bool, err error) {
+	v, ok := meta[key]
+	if ok && v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	switch {
+	case ok && v.IsBool():
+		return v.BoolValue(), true, nil
+	case ok && v.IsString() && v.StringValue() != "":
+		b, err := strconv.ParseBool(v.StringValue())
+		if err != nil {
+			return false, true, checkFailure(string(key), "yandex:%s must be a boolean, got %q", key, v.StringValue())
+		}
+		return b, true, nil
+	}
+	return false, false, nil
+}
+
+// configInt reads an integer provider option, which may also arrive as its string form. set
+// reports whether the option was given at all.
+func configInt(meta resource.PropertyMap, key resource.PropertyKey) (value int, set bool, err error) {
+	v, ok := meta[key]
+	if !ok || v.IsNull() {
+		return 0, false, nil
+	}
+	n, err := intValue(v)
+	if err != nil {
+		return 0, true, checkFailure(string(key), "yandex:%s must be a number: %v", key, err)
+	}
+	return n, true, nil
+}
+
+// configIntMap reads a map of integers provider option. Like configStringMap, it accepts both
+// the map and its JSON encoding.
+func configIntMap(meta resource.PropertyMap, key resource.PropertyKey) (map[string]int, error) {
+	v, ok := meta[key]
+	if !ok || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+
+	result := map[string]int{}
+	switch {
+	case v.IsString():
+		if v.StringValue() == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v.StringValue()), &result); err != nil {
+			return nil, fmt.Errorf("provider option %s must be a map of numbers: %w", key, err)
+		}
+	case v.IsObject():
+		for k, e := range v.ObjectValue() {
+			n, err := intValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("provider option %s must be a map of numbers, %s: %w", key, k, err)
+			}
+			result[string(k)] = n
+		}
+	default:
+		return nil, fmt.Errorf("provider option %s must be a map of numbers, got %s", key, v.TypeString())
+	}
+	return result, nil
+}
+
+func intValue(v resource.PropertyValue) (int, error) {
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	switch {
+	case v.IsNumber():
+		return int(v.NumberValue()), nil
+	case v.IsString():
+		return strconv.Atoi(v.StringValue())
+	}
+	return 0, fmt.Errorf("expected a number, got %s", v.TypeString())
+}
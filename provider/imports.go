@@ -0,0 +1,183 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// importIDFormats lists the resources whose import ID is composed of several attributes rather
+// than being the ID of the cloud object. Attributes are written as {attribute}; everything else
+// is literal.
+var importIDFormats = map[string]string{
+	"yandex_alb_virtual_host":        "{http_router_id}/{name}",
+	"yandex_dns_recordset":           "{zone_id}/{name}/{type}",
+	"yandex_mdb_clickhouse_database": "{cluster_id}:{name}",
+	"yandex_mdb_clickhouse_user":     "{cluster_id}:{name}",
+	"yandex_mdb_kafka_connector":     "{cluster_id}:{name}",
+	"yandex_mdb_kafka_topic":         "{cluster_id}:{name}",
+	"yandex_mdb_kafka_user":          "{cluster_id}:{name}",
+	"yandex_mdb_mongodb_database":    "{cluster_id}:{name}",
+	"yandex_mdb_mongodb_user":        "{cluster_id}:{name}",
+	"yandex_mdb_mysql_database":      "{cluster_id}:{name}",
+	"yandex_mdb_mysql_user":          "{cluster_id}:{name}",
+	"yandex_mdb_postgresql_database": "{cluster_id}:{name}",
+	"yandex_mdb_postgresql_user":     "{cluster_id}:{name}",
+	"yandex_mdb_redis_user":          "{cluster_id}:{name}",
+	"yandex_ydb_table_index":         "{table_id}/{name}",
+}
+
+// iamParentIDs maps the resources that carry IAM policy, binding and member resources to the
+// attribute holding the ID of the object the access is granted on.
+var iamParentIDs = map[string]string{
+	"yandex_api_gateway":                      "api_gateway_id",
+	"yandex_cm_certificate":                   "certificate_id",
+	"yandex_compute_disk":                     "disk_id",
+	"yandex_compute_disk_placement_group":     "disk_placement_group_id",
+	"yandex_compute_filesystem":               "filesystem_id",
+	"yandex_compute_gpu_cluster":              "gpu_cluster_id",
+	"yandex_compute_image":                    "image_id",
+	"yandex_compute_instance":                 "instance_id",
+	"yandex_compute_placement_group":          "placement_group_id",
+	"yandex_compute_snapshot":                 "snapshot_id",
+	"yandex_compute_snapshot_schedule":        "snapshot_schedule_id",
+	"yandex_container_registry":               "registry_id",
+	"yandex_container_repository":             "repository_id",
+	"yandex_dns_zone":                         "dns_zone_id",
+	"yandex_function":                         "function_id",
+	"yandex_iam_service_account":              "service_account_id",
+	"yandex_kms_asymmetric_encryption_key":    "asymmetric_encryption_key_id",
+	"yandex_kms_asymmetric_signature_key":     "asymmetric_signature_key_id",
+	"yandex_kms_symmetric_key":                "symmetric_key_id",
+	"yandex_lockbox_secret":                   "secret_id",
+	"yandex_logging_group":                    "group_id",
+	"yandex_mdb_clickhouse_cluster":           "cluster_id",
+	"yandex_mdb_greenplum_cluster":            "cluster_id",
+	"yandex_mdb_kafka_cluster":                "cluster_id",
+	"yandex_mdb_mongodb_cluster":              "cluster_id",
+	"yandex_mdb_mysql_cluster":                "cluster_id",
+	"yandex_mdb_opensearch_cluster":           "cluster_id",
+	"yandex_mdb_postgresql_cluster":           "cluster_id",
+	"yandex_mdb_redis_cluster":                "cluster_id",
+	"yandex_organizationmanager_organization": "organization_id",
+	"yandex_resourcemanager_cloud":            "cloud_id",
+	"yandex_resourcemanager_folder":           "folder_id",
+	"yandex_serverless_container":             "container_id",
+	"yandex_storage_bucket":                   "bucket",
+	"yandex_vpc_address":                      "address_id",
+	"yandex_vpc_gateway":                      "gateway_id",
+	"yandex_vpc_network":                      "network_id",
+	"yandex_vpc_route_table":                  "route_table_id",
+	"yandex_vpc_security_group":               "security_group_id",
+	"yandex_vpc_subnet":                       "subnet_id",
+	"yandex_ydb_database":                     "database_id",
+}
+
+// iamResourceSuffixes maps the suffix of every IAM resource to the part of its import ID that
+// follows the parent ID.
+var iamResourceSuffixes = map[string]string{
+	"_iam_policy":  "",
+	"_iam_binding": " {role}",
+	"_iam_member":  " {role} {member}",
+}
+
+// iamParent returns the resource an IAM policy, binding or member resource grants access on,
+// and the suffix that names its kind.
+func iamParent(tfToken string) (parent, suffix string, ok bool) {
+	for suffix := range iamResourceSuffixes {
+		if parent, ok := strings.CutSuffix(tfToken, suffix); ok {
+			return parent, suffix, true
+		}
+	}
+	return "", "", false
+}
+
+// importIDFormat returns the import ID format of a resource, or "" when the resource is imported
+// by the ID of its cloud object.
+func importIDFormat(tfToken string) string {
+	if format, ok := importIDFormats[tfToken]; ok {
+		return format
+	}
+	if parent, suffix, ok := iamParent(tfToken); ok {
+		if id, ok := iamParentIDs[parent]; ok {
+			return "{" + id + "}" + iamResourceSuffixes[suffix]
+		}
+	}
+	return ""
+}
+
+// applyImportIDs documents the import ID of every resource with a composite one. Plugin-framework
+// resources without an id attribute get their Pulumi ID computed from the same format, so they
+// import the same way as the SDKv2 ones.
+func applyImportIDs(prov *tfbridge.ProviderInfo) {
+	pf, _ := prov.P.(interface{ ResourceIsPF(string) bool })
+	prov.P.ResourcesMap().Range(func(name string, res shim.Resource) bool {
+		info := prov.Resources[name]
+		format := importIDFormat(name)
+		if info == nil || format == "" {
+			return true
+		}
+		if info.Docs == nil {
+			info.Docs = &tfbridge.DocInfo{}
+		}
+		if info.Docs.ImportDetails == "" {
+			info.Docs.ImportDetails = importDetails(string(info.Tok), format)
+		}
+		if _, hasID := res.Schema().GetOk("id"); !hasID && pf != nil && pf.ResourceIsPF(name) {
+			info.ComputeID = computeImportID(format, res.Schema(), info.Fields)
+		}
+		return true
+	})
+}
+
+func importDetails(tok, format string) string {
+	return fmt.Sprintf("The resource can be imported by using its resource ID, which has the form `%s`.\n\n"+
+		"```sh\n$ pulumi import %s <resource name> %s\n```\n", format, tok, format)
+}
+
+var importIDField = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+// computeImportID builds the ID of a resource from its state by filling in format.
+func computeImportID(
+	format string, sch shim.SchemaMap, fields map[string]*tfbridge.SchemaInfo,
+) tfbridge.ComputeID {
+	return func(_ context.Context, state resource.PropertyMap) (resource.ID, error) {
+		var missing []string
+		id := importIDField.ReplaceAllStringFunc(format, func(m string) string {
+			name := m[1 : len(m)-1]
+			key := resource.PropertyKey(tfbridge.TerraformToPulumiNameV2(name, sch, fields))
+			v, ok := state[key]
+			if ok && v.IsSecret() {
+				v = v.SecretValue().Element
+			}
+			if !ok || !v.IsString() || v.StringValue() == "" {
+				missing = append(missing, string(key))
+				return ""
+			}
+			return v.StringValue()
+		})
+		if len(missing) > 0 {
+			return "", fmt.Errorf("cannot compute the resource ID: %s not set", strings.Join(missing, ", "))
+		}
+		return resource.ID(id), nil
+	}
+}
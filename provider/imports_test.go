@@ -0,0 +1,106 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	shimv2 "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/sdk-v2"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestImportIDDocs(t *testing.T) {
+	prov := Provider()
+
+	want := map[string]string{
+		"yandex_mdb_postgresql_user":                "{cluster_id}:{name}",
+		"yandex_mdb_kafka_topic":                    "{cluster_id}:{name}",
+		"yandex_mdb_clickhouse_user":                "{cluster_id}:{name}",
+		"yandex_mdb_mongodb_database":               "{cluster_id}:{name}",
+		"yandex_mdb_redis_user":                     "{cluster_id}:{name}",
+		"yandex_dns_recordset":                      "{zone_id}/{name}/{type}",
+		"yandex_alb_virtual_host":                   "{http_router_id}/{name}",
+		"yandex_ydb_table_index":                    "{table_id}/{name}",
+		"yandex_resourcemanager_folder_iam_policy":  "{folder_id}",
+		"yandex_resourcemanager_folder_iam_binding": "{folder_id} {role}",
+		"yandex_iam_service_account_iam_member":     "{service_account_id} {role} {member}",
+		"yandex_compute_instance_iam_binding":       "{instance_id} {role}",
+		"yandex_storage_bucket_iam_member":          "{bucket} {role} {member}",
+		"yandex_mdb_kafka_cluster_iam_member":       "{cluster_id} {role} {member}",
+	}
+	for tfToken, format := range want {
+		info := prov.Resources[tfToken]
+		if info == nil || info.Docs == nil {
+			t.Errorf("%s: no docs", tfToken)
+			continue
+		}
+		snippet := "pulumi import " + string(info.Tok) + " <resource name> " + format
+		if !strings.Contains(info.Docs.ImportDetails, snippet) {
+			t.Errorf("%s: import details %q do not contain %q", tfToken, info.Docs.ImportDetails, snippet)
+		}
+	}
+
+	for tfToken := range importIDFormats {
+		if _, ok := prov.Resources[tfToken]; !ok {
+			t.Errorf("%s has an import ID format but is not a resource", tfToken)
+		}
+	}
+	pf, _ := prov.P.(interface{ ResourceIsPF(string) bool })
+	prov.P.ResourcesMap().Range(func(name string, res shim.Resource) bool {
+		if _, _, ok := iamParent(name); ok && importIDFormat(name) == "" {
+			t.Errorf("%s: no import ID format; add its parent to iamParentIDs", name)
+		}
+		_, hasID := res.Schema().GetOk("id")
+		if !hasID && pf != nil && pf.ResourceIsPF(name) && prov.Resources[name].ComputeID == nil {
+			t.Errorf("%s has no id attribute and no ComputeID", name)
+		}
+		return true
+	})
+	if info := prov.Resources["yandex_vpc_network"]; info.Docs != nil && info.Docs.ImportDetails != "" {
+		t.Errorf("yandex_vpc_network: unexpected import details %q", info.Docs.ImportDetails)
+	}
+}
+
+func TestComputeImportID(t *testing.T) {
+	sch := shimv2.NewSchemaMap(map[string]*schema.Schema{
+		"zone_id": {Type: schema.TypeString, Required: true},
+		"name":    {Type: schema.TypeString, Required: true},
+		"type":    {Type: schema.TypeString, Required: true},
+	})
+	computeID := computeImportID("{zone_id}/{name}/{type}", sch, nil)
+
+	id, err := computeID(context.Background(), resource.PropertyMap{
+		"zoneId": resource.NewStringProperty("dns9m"),
+		"name":   resource.MakeSecret(resource.NewStringProperty("srv.example.com.")),
+		"type":   resource.NewStringProperty("A"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "dns9m/srv.example.com./A" {
+		t.Errorf("got ID %q", id)
+	}
+
+	_, err = computeID(context.Background(), resource.PropertyMap{
+		"name": resource.NewStringProperty("srv.example.com."),
+	})
+	if err == nil || !strings.Contains(err.Error(), "zoneId, type not set") {
+		t.Errorf("got error %v, want the missing attributes", err)
+	}
+}
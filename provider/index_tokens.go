@@ -0,0 +1,266 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"strings"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+)
+
+// indexResources lists the resources that were published in mainMod before tokens moved into
+// service modules, by this package or by the v0.13 pulumi-yandex package MIGRATION.md covers.
+// Only these get an alias back to their old mainMod token; resources added since never had one.
+var indexResources = map[string]bool{
+	// Published by v0.13 only.
+	"yandex_resourcemanager_folder":  true,
+	"yandex_vpc_security_group_rule": true,
+
+	// IAM bindings and members, published by v0.13 and restored since.
+	"yandex_container_registry_iam_binding":               true,
+	"yandex_container_repository_iam_binding":             true,
+	"yandex_function_iam_binding":                         true,
+	"yandex_iam_service_account_iam_binding":              true,
+	"yandex_iam_service_account_iam_member":               true,
+	"yandex_kms_symmetric_key_iam_binding":                true,
+	"yandex_organizationmanager_organization_iam_binding": true,
+	"yandex_organizationmanager_organization_iam_member":  true,
+	"yandex_resourcemanager_cloud_iam_binding":            true,
+	"yandex_resourcemanager_cloud_iam_member":             true,
+	"yandex_resourcemanager_folder_iam_binding":           true,
+	"yandex_resourcemanager_folder_iam_member":            true,
+
+	"yandex_alb_backend_group":                                true,
+	"yandex_alb_http_router":                                  true,
+	"yandex_alb_load_balancer":                                true,
+	"yandex_alb_target_group":                                 true,
+	"yandex_alb_virtual_host":                                 true,
+	"yandex_api_gateway":                                      true,
+	"yandex_audit_trails_trail":                               true,
+	"yandex_backup_policy":                                    true,
+	"yandex_backup_policy_bindings":                           true,
+	"yandex_cdn_origin_group":                                 true,
+	"yandex_cdn_resource":                                     true,
+	"yandex_cm_certificate":                                   true,
+	"yandex_compute_disk":                                     true,
+	"yandex_compute_disk_placement_group":                     true,
+	"yandex_compute_filesystem":                               true,
+	"yandex_compute_gpu_cluster":                              true,
+	"yandex_compute_image":                                    true,
+	"yandex_compute_instance":                                 true,
+	"yandex_compute_instance_group":                           true,
+	"yandex_compute_placement_group":                          true,
+	"yandex_compute_snapshot":                                 true,
+	"yandex_compute_snapshot_schedule":                        true,
+	"yandex_container_registry":                               true,
+	"yandex_container_registry_ip_permission":                 true,
+	"yandex_container_repository":                             true,
+	"yandex_container_repository_lifecycle_policy":            true,
+	"yandex_dataproc_cluster":                                 true,
+	"yandex_datatransfer_endpoint":                            true,
+	"yandex_datatransfer_transfer":                            true,
+	"yandex_dns_recordset":                                    true,
+	"yandex_dns_zone":                                         true,
+	"yandex_function":                                         true,
+	"yandex_function_scaling_policy":                          true,
+	"yandex_function_trigger":                                 true,
+	"yandex_iam_service_account":                              true,
+	"yandex_iam_service_account_api_key":                      true,
+	"yandex_iam_service_account_iam_policy":                   true,
+	"yandex_iam_service_account_key":                          true,
+	"yandex_iam_service_account_static_access_key":            true,
+	"yandex_iam_workload_identity_federated_credential":       true,
+	"yandex_iam_workload_identity_oidc_federation":            true,
+	"yandex_iot_core_broker":                                  true,
+	"yandex_iot_core_device":                                  true,
+	"yandex_iot_core_registry":                                true,
+	"yandex_kms_asymmetric_encryption_key":                    true,
+	"yandex_kms_asymmetric_signature_key":                     true,
+	"yandex_kms_secret_ciphertext":                            true,
+	"yandex_kms_symmetric_key":                                true,
+	"yandex_kubernetes_cluster":                               true,
+	"yandex_kubernetes_node_group":                            true,
+	"yandex_lb_network_load_balancer":                         true,
+	"yandex_lb_target_group":                                  true,
+	"yandex_loadtesting_agent":                                true,
+	"yandex_lockbox_secret":                                   true,
+	"yandex_lockbox_secret_version":                           true,
+	"yandex_lockbox_secret_version_hashed":                    true,
+	"yandex_logging_group":                                    true,
+	"yandex_mdb_clickhouse_cluster":                           true,
+	"yandex_mdb_greenplum_cluster":                            true,
+	"yandex_mdb_kafka_cluster":                                true,
+	"yandex_mdb_kafka_connector":                              true,
+	"yandex_mdb_kafka_topic":                                  true,
+	"yandex_mdb_kafka_user":                                   true,
+	"yandex_mdb_mongodb_cluster":                              true,
+	"yandex_mdb_mysql_cluster":                                true,
+	"yandex_mdb_mysql_database":                               true,
+	"yandex_mdb_mysql_user":                                   true,
+	"yandex_mdb_postgresql_cluster":                           true,
+	"yandex_mdb_postgresql_database":                          true,
+	"yandex_mdb_postgresql_user":                              true,
+	"yandex_mdb_redis_cluster":                                true,
+	"yandex_mdb_sqlserver_cluster":                            true,
+	"yandex_message_queue":                                    true,
+	"yandex_monitoring_dashboard":                             true,
+	"yandex_organizationmanager_group":                        true,
+	"yandex_organizationmanager_group_mapping":                true,
+	"yandex_organizationmanager_group_mapping_item":           true,
+	"yandex_organizationmanager_group_membership":             true,
+	"yandex_organizationmanager_os_login_settings":            true,
+	"yandex_organizationmanager_saml_federation":              true,
+	"yandex_organizationmanager_saml_federation_user_account": true,
+	"yandex_organizationmanager_user_ssh_key":                 true,
+	"yandex_resourcemanager_folder_iam_policy":                true,
+	"yandex_serverless_container":                             true,
+	"yandex_serverless_eventrouter_bus":                       true,
+	"yandex_serverless_eventrouter_connector":                 true,
+	"yandex_serverless_eventrouter_rule":                      true,
+	"yandex_smartcaptcha_captcha":                             true,
+	"yandex_storage_bucket":                                   true,
+	"yandex_storage_object":                                   true,
+	"yandex_sws_advanced_rate_limiter_profile":                true,
+	"yandex_sws_security_profile":                             true,
+	"yandex_sws_waf_profile":                                  true,
+	"yandex_vpc_address":                                      true,
+	"yandex_vpc_default_security_group":                       true,
+	"yandex_vpc_gateway":                                      true,
+	"yandex_vpc_network":                                      true,
+	"yandex_vpc_private_endpoint":                             true,
+	"yandex_vpc_route_table":                                  true,
+	"yandex_vpc_security_group":                               true,
+	"yandex_vpc_subnet":                                       true,
+	"yandex_ydb_database_dedicated":                           true,
+	"yandex_ydb_database_serverless":                          true,
+	"yandex_ydb_table":                                        true,
+	"yandex_ydb_table_changefeed":                             true,
+	"yandex_ydb_table_index":                                  true,
+	"yandex_ydb_topic":                                        true,
+}
+
+// indexDataSources lists the data sources that were published in mainMod as yandex.getXxx
+// functions before tokens moved into service modules.
+var indexDataSources = map[string]bool{
+	"yandex_alb_backend_group":                                true,
+	"yandex_alb_http_router":                                  true,
+	"yandex_alb_load_balancer":                                true,
+	"yandex_alb_target_group":                                 true,
+	"yandex_alb_virtual_host":                                 true,
+	"yandex_api_gateway":                                      true,
+	"yandex_audit_trails_trail":                               true,
+	"yandex_backup_policy":                                    true,
+	"yandex_cdn_origin_group":                                 true,
+	"yandex_cdn_resource":                                     true,
+	"yandex_client_config":                                    true,
+	"yandex_cm_certificate":                                   true,
+	"yandex_cm_certificate_content":                           true,
+	"yandex_compute_disk":                                     true,
+	"yandex_compute_disk_placement_group":                     true,
+	"yandex_compute_filesystem":                               true,
+	"yandex_compute_gpu_cluster":                              true,
+	"yandex_compute_image":                                    true,
+	"yandex_compute_instance":                                 true,
+	"yandex_compute_instance_group":                           true,
+	"yandex_compute_placement_group":                          true,
+	"yandex_compute_snapshot":                                 true,
+	"yandex_compute_snapshot_schedule":                        true,
+	"yandex_container_registry":                               true,
+	"yandex_container_registry_ip_permission":                 true,
+	"yandex_container_repository":                             true,
+	"yandex_container_repository_lifecycle_policy":            true,
+	"yandex_dataproc_cluster":                                 true,
+	"yandex_dns_zone":                                         true,
+	"yandex_function":                                         true,
+	"yandex_function_scaling_policy":                          true,
+	"yandex_function_trigger":                                 true,
+	"yandex_iam_policy":                                       true,
+	"yandex_iam_role":                                         true,
+	"yandex_iam_service_account":                              true,
+	"yandex_iam_service_agent":                                true,
+	"yandex_iam_user":                                         true,
+	"yandex_iam_workload_identity_federated_credential":       true,
+	"yandex_iam_workload_identity_oidc_federation":            true,
+	"yandex_iot_core_broker":                                  true,
+	"yandex_iot_core_device":                                  true,
+	"yandex_iot_core_registry":                                true,
+	"yandex_kms_asymmetric_encryption_key":                    true,
+	"yandex_kms_asymmetric_signature_key":                     true,
+	"yandex_kms_symmetric_key":                                true,
+	"yandex_kubernetes_cluster":                               true,
+	"yandex_kubernetes_node_group":                            true,
+	"yandex_lb_network_load_balancer":                         true,
+	"yandex_lb_target_group":                                  true,
+	"yandex_loadtesting_agent":                                true,
+	"yandex_lockbox_secret":                                   true,
+	"yandex_lockbox_secret_version":                           true,
+	"yandex_logging_group":                                    true,
+	"yandex_mdb_clickhouse_cluster":                           true,
+	"yandex_mdb_greenplum_cluster":                            true,
+	"yandex_mdb_kafka_cluster":                                true,
+	"yandex_mdb_kafka_connector":                              true,
+	"yandex_mdb_kafka_topic":                                  true,
+	"yandex_mdb_kafka_user":                                   true,
+	"yandex_mdb_mongodb_cluster":                              true,
+	"yandex_mdb_mysql_cluster":                                true,
+	"yandex_mdb_mysql_database":                               true,
+	"yandex_mdb_mysql_user":                                   true,
+	"yandex_mdb_postgresql_cluster":                           true,
+	"yandex_mdb_postgresql_database":                          true,
+	"yandex_mdb_postgresql_user":                              true,
+	"yandex_mdb_redis_cluster":                                true,
+	"yandex_mdb_sqlserver_cluster":                            true,
+	"yandex_message_queue":                                    true,
+	"yandex_monitoring_dashboard":                             true,
+	"yandex_organizationmanager_group":                        true,
+	"yandex_organizationmanager_os_login_settings":            true,
+	"yandex_organizationmanager_saml_federation":              true,
+	"yandex_organizationmanager_saml_federation_user_account": true,
+	"yandex_organizationmanager_user_ssh_key":                 true,
+	"yandex_resourcemanager_cloud":                            true,
+	"yandex_resourcemanager_folder":                           true,
+	"yandex_serverless_container":                             true,
+	"yandex_serverless_eventrouter_bus":                       true,
+	"yandex_serverless_eventrouter_connector":                 true,
+	"yandex_serverless_eventrouter_rule":                      true,
+	"yandex_smartcaptcha_captcha":                             true,
+	"yandex_sws_advanced_rate_limiter_profile":                true,
+	"yandex_sws_security_profile":                             true,
+	"yandex_sws_waf_profile":                                  true,
+	"yandex_sws_waf_rule_set_descriptor":                      true,
+	"yandex_vpc_address":                                      true,
+	"yandex_vpc_gateway":                                      true,
+	"yandex_vpc_network":                                      true,
+	"yandex_vpc_private_endpoint":                             true,
+	"yandex_vpc_route_table":                                  true,
+	"yandex_vpc_security_group":                               true,
+	"yandex_vpc_subnet":                                       true,
+	"yandex_ydb_database_dedicated":                           true,
+	"yandex_ydb_database_serverless":                          true,
+}
+
+// applyIndexFunctions keeps the old mainMod functions of indexDataSources as deprecated copies of
+// the service module ones, so programs calling yandex.getVpcNetwork keep working. It runs after
+// the other apply* helpers so the copies share their field overrides.
+func applyIndexFunctions(prov *tfbridge.ProviderInfo) {
+	for _, name := range sortedKeys(indexDataSources) {
+		ds, ok := prov.DataSources[name]
+		if !ok || tokenModule(string(ds.Tok)) == mainMod {
+			continue
+		}
+		legacy := makeDataSource(mainMod, "get"+upperCamel(strings.TrimPrefix(name, "yandex_")))
+		prov.RenameDataSource(name, legacy, ds.Tok, mainMod, tokenModule(string(ds.Tok)), ds)
+	}
+}
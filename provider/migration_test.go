@@ -0,0 +1,160 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// retiredTokens are v0.13 resources that no longer exist upstream. MIGRATION.md describes how to
+// drop them from state before switching packages.
+var retiredTokens = map[tokens.Type]bool{
+	"yandex:index/mdbElasticSearchCluster:MdbElasticSearchCluster": true,
+}
+
+// TestLegacyStateResolves checks that every resource in a stack written by the v0.13
+// pulumi-yandex package is adopted by a current resource, either directly or through an alias,
+// so that switching packages causes no replacements.
+func TestLegacyStateResolves(t *testing.T) {
+	prov := Provider()
+
+	// Map every current token and alias to the resource that adopts it.
+	adopters := map[tokens.Type]string{}
+	for name, r := range prov.Resources {
+		adopters[r.Tok] = name
+		for _, a := range r.Aliases {
+			if a.Type != nil {
+				adopters[tokens.Type(*a.Type)] = name
+			}
+		}
+	}
+
+	b, err := os.ReadFile("testdata/v0.13-stack.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var untyped apitype.UntypedDeployment
+	if err := json.Unmarshal(b, &untyped); err != nil {
+		t.Fatal(err)
+	}
+	var deployment apitype.DeploymentV3
+	if err := json.Unmarshal(untyped.Deployment, &deployment); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[tokens.Type]string{
+		"yandex:index/vpcSecurityGroupRule:VpcSecurityGroupRule":   "yandex_vpc_security_group_rule",
+		"yandex:index/resourcemanagerFolder:ResourcemanagerFolder": "yandex_resourcemanager_folder",
+		"yandex:index/dnsRecordSet:DnsRecordSet":                   "yandex_dns_recordset",
+
+		"yandex:index/resourcemanagerFolderIamMember:ResourcemanagerFolderIamMember":                     "yandex_resourcemanager_folder_iam_member",
+		"yandex:index/resourcemanagerFolderIamBinding:ResourcemanagerFolderIamBinding":                   "yandex_resourcemanager_folder_iam_binding",
+		"yandex:index/resourcemanagerCloudIamMember:ResourcemanagerCloudIamMember":                       "yandex_resourcemanager_cloud_iam_member",
+		"yandex:index/resourcemanagerCloudIamBinding:ResourcemanagerCloudIamBinding":                     "yandex_resourcemanager_cloud_iam_binding",
+		"yandex:index/organizationmanagerOrganizationIamMember:OrganizationmanagerOrganizationIamMember": "yandex_organizationmanager_organization_iam_member",
+		"yandex:index/iamServiceAccountIamBinding:IamServiceAccountIamBinding":                           "yandex_iam_service_account_iam_binding",
+		"yandex:index/iamServiceAccountIamMember:IamServiceAccountIamMember":                             "yandex_iam_service_account_iam_member",
+		"yandex:index/kmsSymmetricKeyIamBinding:KmsSymmetricKeyIamBinding":                               "yandex_kms_symmetric_key_iam_binding",
+		"yandex:index/functionIamBinding:FunctionIamBinding":                                             "yandex_function_iam_binding",
+		"yandex:index/containerRegistryIamBinding:ContainerRegistryIamBinding":                           "yandex_container_registry_iam_binding",
+	}
+	for _, res := range deployment.Resources {
+		if !res.Custom || !strings.HasPrefix(string(res.Type), mainPkg+":") {
+			continue
+		}
+		name, ok := adopters[res.Type]
+		switch {
+		case retiredTokens[res.Type]:
+			if ok {
+				t.Errorf("retired type %s is adopted by %s; drop it from retiredTokens", res.Type, name)
+			}
+		case !ok:
+			t.Errorf("%s (%s) is not adopted by any resource", res.URN, res.Type)
+		case want[res.Type] != "" && want[res.Type] != name:
+			t.Errorf("%s is adopted by %s, want %s", res.Type, name, want[res.Type])
+		}
+	}
+}
+
+// TestClusterV2Move checks what the move from the v1 MySQL and PostgreSQL cluster resources to
+// the v2 ones in MIGRATION.md relies on: the v2 resource imports the cluster by the same ID the
+// v1 resource holds, and is not aliased to the v1 resource, whose state has a different shape.
+func TestClusterV2Move(t *testing.T) {
+	prov := Provider()
+
+	for _, engine := range []string{"mysql", "postgresql"} {
+		v1 := prov.Resources["yandex_mdb_"+engine+"_cluster"]
+		v2 := prov.Resources["yandex_mdb_"+engine+"_cluster_v2"]
+		if v1 == nil || v2 == nil {
+			t.Errorf("%s: both cluster resources must be mapped", engine)
+			continue
+		}
+		if _, ok := prov.DataSources["yandex_mdb_"+engine+"_cluster_v2"]; !ok {
+			t.Errorf("%s: the v2 cluster data source is not mapped", engine)
+		}
+
+		v1Tokens := map[string]bool{string(v1.Tok): true}
+		for _, a := range v1.Aliases {
+			if a.Type != nil {
+				v1Tokens[*a.Type] = true
+			}
+		}
+		for _, a := range v2.Aliases {
+			if a.Type != nil && v1Tokens[*a.Type] {
+				t.Errorf("%s is aliased to the v1 token %s", v2.Tok, *a.Type)
+			}
+		}
+
+		for _, name := range []string{"yandex_mdb_" + engine + "_cluster", "yandex_mdb_" + engine + "_cluster_v2"} {
+			if format := importIDFormat(name); format != "" {
+				t.Errorf("%s is imported by %q, not by the cluster ID", name, format)
+			}
+		}
+	}
+}
+
+// TestDocumentedTokens checks that the resource tokens used in `pulumi import` commands and state
+// URNs in the docs are mapped.
+func TestDocumentedTokens(t *testing.T) {
+	prov := Provider()
+
+	known := map[string]bool{}
+	for _, r := range prov.Resources {
+		known[string(r.Tok)] = true
+	}
+	for tok := range retiredTokens {
+		known[string(tok)] = true
+	}
+
+	tokenPattern := regexp.MustCompile(`(?:pulumi import |::)(yandex:[a-zA-Z0-9/]+:[a-zA-Z0-9]+)`)
+	for _, doc := range []string{"../README.md", "../MIGRATION.md"} {
+		b, err := os.ReadFile(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range tokenPattern.FindAllStringSubmatch(string(b), -1) {
+			if !known[m[1]] {
+				t.Errorf("%s refers to %s, which is not a resource token", doc, m[1])
+			}
+		}
+	}
+}
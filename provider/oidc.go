@@ -0,0 +1,225 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	oidcTokenFileKey    = "oidcTokenFile"
+	oidcTokenEnvKey     = "oidcTokenEnv"
+	serviceAccountIdKey = "serviceAccountId"
+	stsEndpointKey      = "stsEndpoint"
+
+	oidcTokenFileEnv    = "YC_OIDC_TOKEN_FILE"
+	serviceAccountIDEnv = "YC_SERVICE_ACCOUNT_ID"
+	stsEndpointEnv      = "YC_STS_ENDPOINT"
+
+	defaultSTSEndpoint = "https://auth.yandex.cloud/oauth/token"
+)
+
+// The Pulumi-only workload identity federation provider options.
+var (
+	oidcTokenFileConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeString,
+			Optional: true,
+			Description: "Path to a file holding an OIDC token issued by a CI system, which is exchanged for an IAM " +
+				"token of serviceAccountId. The file is read again on every exchange. Can also be set with the " +
+				"YC_OIDC_TOKEN_FILE environment variable.",
+		}).Shim(),
+	}
+	oidcTokenEnvConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeString,
+			Optional: true,
+			Description: "Name of the environment variable holding an OIDC token issued by a CI system, which is " +
+				"exchanged for an IAM token of serviceAccountId.",
+		}).Shim(),
+	}
+	serviceAccountIdConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeString,
+			Optional: true,
+			Description: "ID of the service account that the federated credential of the OIDC token is bound to. " +
+				"Can also be set with the YC_SERVICE_ACCOUNT_ID environment variable.",
+		}).Shim(),
+	}
+	stsEndpointConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeString,
+			Optional: true,
+			Description: "Token exchange endpoint used with oidcTokenFile and oidcTokenEnv. Defaults to " +
+				defaultSTSEndpoint + ". Can also be set with the YC_STS_ENDPOINT environment variable.",
+		}).Shim(),
+	}
+)
+
+// oidcTokenSource exchanges OIDC tokens for IAM tokens with the RFC 8693 token exchange endpoint
+// and caches the result until three quarters of its lifetime have passed.
+type oidcTokenSource struct {
+	endpoint         string
+	serviceAccountID string
+	tokenFile        string
+	tokenEnv         string
+
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+// newOIDCTokenSource returns the token source configured by vars and the environment, or nil when
+// neither oidcTokenFile nor oidcTokenEnv is set.
+func newOIDCTokenSource(vars resource.PropertyMap) (*oidcTokenSource, error) {
+	src := &oidcTokenSource{
+		endpoint:         configStringOrEnv(vars, stsEndpointKey, stsEndpointEnv),
+		serviceAccountID: configStringOrEnv(vars, serviceAccountIdKey, serviceAccountIDEnv),
+		tokenFile:        configStringOrEnv(vars, oidcTokenFileKey, oidcTokenFileEnv),
+		tokenEnv:         configString(vars, oidcTokenEnvKey),
+		client:           &http.Client{Timeout: 30 * time.Second},
+		now:              time.Now,
+	}
+	switch {
+	case src.tokenFile == "" && src.tokenEnv == "":
+		return nil, nil
+	case src.tokenFile != "" && src.tokenEnv != "":
+		return nil, checkFailure(oidcTokenEnvKey, "only one of yandex:%s and yandex:%s may be set",
+			oidcTokenFileKey, oidcTokenEnvKey)
+	case src.serviceAccountID == "":
+		return nil, checkFailure(serviceAccountIdKey,
+			"yandex:%s (%s) is required to exchange an OIDC token", serviceAccountIdKey, serviceAccountIDEnv)
+	}
+	if src.endpoint == "" {
+		src.endpoint = defaultSTSEndpoint
+	}
+	return src, nil
+}
+
+// Token returns an IAM token, exchanging a new OIDC token when the cached one is due for refresh.
+// refreshed reports whether the token changed.
+func (s *oidcTokenSource) Token(ctx context.Context) (token string, refreshed bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && s.now().Before(s.refreshAt) {
+		return s.token, false, nil
+	}
+
+	token, lifetime, err := s.exchange(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	s.token = token
+	s.refreshAt = s.now().Add(lifetime * 3 / 4)
+	return token, true, nil
+}
+
+// expiring reports whether the next call to Token will exchange a new token.
+func (s *oidcTokenSource) expiring() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token == "" || !s.now().Before(s.refreshAt)
+}
+
+type stsResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (s *oidcTokenSource) exchange(ctx context.Context) (string, time.Duration, error) {
+	subject, err := s.subjectToken()
+	if err != nil {
+		return "", 0, err
+	}
+
+	form := url.Values{
+		"grant_type":           {"urn:ietf:params:oauth:grant-type:token-exchange"},
+		"requested_token_type": {"urn:ietf:params:oauth:token-type:access_token"},
+		"audience":             {s.serviceAccountID},
+		"subject_token":        {subject},
+		"subject_token_type":   {"urn:ietf:params:oauth:token-type:id_token"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("exchanging the OIDC token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", 0, fmt.Errorf("exchanging the OIDC token: %w", err)
+	}
+
+	var r stsResponse
+	if err := json.Unmarshal(body, &r); err != nil && resp.StatusCode == http.StatusOK {
+		return "", 0, fmt.Errorf("exchanging the OIDC token: malformed response: %w", err)
+	}
+	switch {
+	case resp.StatusCode != http.StatusOK && r.Error != "":
+		return "", 0, fmt.Errorf("exchanging the OIDC token for service account %s: %s: %s",
+			s.serviceAccountID, r.Error, r.ErrorDescription)
+	case resp.StatusCode != http.StatusOK:
+		return "", 0, fmt.Errorf("exchanging the OIDC token for service account %s: %s",
+			s.serviceAccountID, resp.Status)
+	case r.AccessToken == "" || r.ExpiresIn <= 0:
+		return "", 0, fmt.Errorf("exchanging the OIDC token: the response has no access_token or expires_in")
+	}
+	return r.AccessToken, time.Duration(r.ExpiresIn) * time.Second, nil
+}
+
+// subjectToken reads the OIDC token issued by the CI system.
+func (s *oidcTokenSource) subjectToken() (string, error) {
+	if s.tokenEnv != "" {
+		token := strings.TrimSpace(os.Getenv(s.tokenEnv))
+		if token == "" {
+			return "", fmt.Errorf("yandex:%s: environment variable %s is empty", oidcTokenEnvKey, s.tokenEnv)
+		}
+		return token, nil
+	}
+
+	b, err := os.ReadFile(s.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("yandex:%s: %w", oidcTokenFileKey, err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("yandex:%s: %s is empty", oidcTokenFileKey, s.tokenFile)
+	}
+	return token, nil
+}
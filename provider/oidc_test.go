@@ -0,0 +1,357 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// fakeSTS stands in for the IAM token exchange endpoint. It accepts subject tokens of the form
+// "jwt-<n>" for service account ajesa and issues "iam-<n>-<issued>" tokens.
+type fakeSTS struct {
+	*httptest.Server
+	lifetime time.Duration
+
+	mu     sync.Mutex
+	issued int
+}
+
+func newFakeSTS(t *testing.T, lifetime time.Duration) *fakeSTS {
+	sts := &fakeSTS{lifetime: lifetime}
+	sts.Server = httptest.NewServer(http.HandlerFunc(sts.serve))
+	t.Cleanup(sts.Close)
+	return sts
+}
+
+func (s *fakeSTS) serve(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fail := func(code, desc string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": code, "error_description": desc})
+	}
+	switch {
+	case r.Method != http.MethodPost:
+		fail("invalid_request", "POST expected")
+	case r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:token-exchange",
+		r.PostForm.Get("requested_token_type") != "urn:ietf:params:oauth:token-type:access_token",
+		r.PostForm.Get("subject_token_type") != "urn:ietf:params:oauth:token-type:id_token":
+		fail("invalid_request", "not a token exchange request")
+	case r.PostForm.Get("audience") != "ajesa":
+		fail("invalid_target", "unknown service account")
+	case !strings.HasPrefix(r.PostForm.Get("subject_token"), "jwt-"):
+		fail("invalid_grant", "subject token is not trusted by any federation")
+	default:
+		s.mu.Lock()
+		s.issued++
+		token := fmt.Sprintf("iam-%s-%d", strings.TrimPrefix(r.PostForm.Get("subject_token"), "jwt-"), s.issued)
+		s.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":      token,
+			"issued_token_type": "urn:ietf:params:oauth:token-type:access_token",
+			"token_type":        "Bearer",
+			"expires_in":        int(s.lifetime.Seconds()),
+		})
+	}
+}
+
+func oidcVars(sts *fakeSTS, extra map[string]interface{}) resource.PropertyMap {
+	vars := map[string]interface{}{"serviceAccountId": "ajesa", "stsEndpoint": sts.URL}
+	for k, v := range extra {
+		vars[k] = v
+	}
+	return resource.NewPropertyMapFromMap(vars)
+}
+
+func TestOIDCTokenExchange(t *testing.T) {
+	sts := newFakeSTS(t, time.Hour)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("jwt-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CI_JOB_JWT", "jwt-env")
+
+	for _, env := range []string{oidcTokenFileEnv, serviceAccountIDEnv, stsEndpointEnv} {
+		t.Setenv(env, "")
+	}
+
+	tests := []struct {
+		name    string
+		vars    resource.PropertyMap
+		env     map[string]string
+		token   string
+		wantErr string
+	}{
+		{name: "file", vars: oidcVars(sts, map[string]interface{}{"oidcTokenFile": tokenFile}), token: "iam-file-"},
+		{
+			name:  "environment",
+			vars:  resource.PropertyMap{},
+			env:   map[string]string{oidcTokenFileEnv: tokenFile, serviceAccountIDEnv: "ajesa", stsEndpointEnv: sts.URL},
+			token: "iam-file-",
+		},
+		{name: "env", vars: oidcVars(sts, map[string]interface{}{"oidcTokenEnv": "CI_JOB_JWT"}), token: "iam-env-"},
+		{
+			name:    "untrusted token",
+			vars:    oidcVars(sts, map[string]interface{}{"oidcTokenEnv": "HOME"}),
+			wantErr: "invalid_grant: subject token is not trusted",
+		},
+		{
+			name: "wrong service account",
+			vars: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"oidcTokenFile": tokenFile, "serviceAccountId": "other", "stsEndpoint": sts.URL,
+			}),
+			wantErr: "for service account other: invalid_target",
+		},
+		{
+			name:    "empty env",
+			vars:    oidcVars(sts, map[string]interface{}{"oidcTokenEnv": "YANDEX_TEST_UNSET"}),
+			wantErr: "YANDEX_TEST_UNSET is empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			src, err := newOIDCTokenSource(tt.vars)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if src == nil {
+				t.Fatal("no token source configured")
+			}
+			token, _, err := src.Token(context.Background())
+			switch {
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case !strings.HasPrefix(token, tt.token):
+				t.Errorf("got token %q, want it to start with %q", token, tt.token)
+			}
+		})
+	}
+}
+
+func TestOIDCTokenRefresh(t *testing.T) {
+	sts := newFakeSTS(t, time.Hour)
+	t.Setenv("CI_JOB_JWT", "jwt-env")
+	src, err := newOIDCTokenSource(oidcVars(sts, map[string]interface{}{"oidcTokenEnv": "CI_JOB_JWT"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	src.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	first, refreshed, err := src.Token(ctx)
+	if err != nil || !refreshed {
+		t.Fatalf("first exchange: %q, %v, %v", first, refreshed, err)
+	}
+
+	now = now.Add(40 * time.Minute)
+	if token, refreshed, _ := src.Token(ctx); token != first || refreshed || src.expiring() {
+		t.Errorf("token was exchanged again %s into its lifetime", 40*time.Minute)
+	}
+
+	now = now.Add(5 * time.Minute)
+	if !src.expiring() {
+		t.Errorf("token is not due for refresh at three quarters of its lifetime")
+	}
+	second, refreshed, err := src.Token(ctx)
+	if err != nil || !refreshed || second == first {
+		t.Errorf("refresh: %q, %v, %v", second, refreshed, err)
+	}
+}
+
+// TestOIDCServerReconfigure configures the guarded server with an OIDC token source and checks
+// that the muxed server, and so both halves of the upstream provider, gets the exchanged token
+// and is reconfigured with a fresh one once it is due for refresh.
+func TestOIDCServerReconfigure(t *testing.T) {
+	const urn = "urn:pulumi:prod::infra::yandex:mdb/opensearchCluster:OpensearchCluster::search"
+	sts := newFakeSTS(t, time.Hour)
+	t.Setenv("CI_JOB_JWT", "jwt-env")
+	t.Setenv(tokenCredential.env, "")
+
+	inner := &recordingServer{}
+	s := newGuardedServer(inner, &providerGuard{}, nil).(*guardedServer)
+	vars := oidcVars(sts, map[string]interface{}{"oidcTokenEnv": "CI_JOB_JWT", "folderId": "b1gfolder"})
+	if err := configureServer(t, s, vars.Mappable()); err != nil {
+		t.Fatal(err)
+	}
+
+	oidc := s.guard.oidc.Load()
+	now := time.Now().Add(50 * time.Minute)
+	oidc.now = func() time.Time { return now }
+	if _, err := s.Read(context.Background(), &pulumirpc.ReadRequest{Urn: urn, Id: "c9q1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var tokens []string
+	for _, req := range inner.configured {
+		token := req.GetArgs().GetFields()["token"].GetStringValue()
+		if v := req.GetVariables()["yandex:config:token"]; v != token {
+			t.Errorf("configured with token %q in the args but %q in the variables", token, v)
+		}
+		if req.GetArgs().GetFields()["folderId"].GetStringValue() != "b1gfolder" {
+			t.Errorf("folderId was lost")
+		}
+		tokens = append(tokens, token)
+	}
+	if len(tokens) != 2 || !strings.HasPrefix(tokens[0], "iam-env-") || !strings.HasPrefix(tokens[1], "iam-env-") ||
+		tokens[0] == tokens[1] {
+		t.Fatalf("upstream was configured with tokens %q", tokens)
+	}
+	if got := strings.Join(inner.calls, ","); got != "configure,configure,read" {
+		t.Errorf("got calls %s, want the reconfiguration before the read", got)
+	}
+	if got := os.Getenv(tokenCredential.env); got != "" {
+		t.Errorf("%s was set to %q", tokenCredential.env, got)
+	}
+}
+
+// blockingServer is an upstream provider whose creates wait until release is closed.
+type blockingServer struct {
+	pulumirpc.UnimplementedResourceProviderServer
+	started, release chan struct{}
+
+	mu         sync.Mutex
+	configured int
+}
+
+func (s *blockingServer) Configure(context.Context, *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.configured++
+	return &pulumirpc.ConfigureResponse{}, nil
+}
+
+func (s *blockingServer) Create(context.Context, *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+	close(s.started)
+	<-s.release
+	return &pulumirpc.CreateResponse{Id: "c9q1"}, nil
+}
+
+func (s *blockingServer) Read(context.Context, *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	return &pulumirpc.ReadResponse{Id: "c9q1"}, nil
+}
+
+// TestOIDCRefreshDuringOperation checks that a refresh due while a long-running create is in
+// flight reconfigures the upstream provider without waiting for the create to finish.
+func TestOIDCRefreshDuringOperation(t *testing.T) {
+	const urn = "urn:pulumi:prod::infra::yandex:mdb/opensearchCluster:OpensearchCluster::search"
+	sts := newFakeSTS(t, time.Hour)
+	t.Setenv("CI_JOB_JWT", "jwt-env")
+
+	inner := &blockingServer{started: make(chan struct{}), release: make(chan struct{})}
+	s := newGuardedServer(inner, &providerGuard{}, &recordingLogger{}).(*guardedServer)
+	vars := oidcVars(sts, map[string]interface{}{"oidcTokenEnv": "CI_JOB_JWT", "folderId": "b1gfolder"})
+	if err := configureServer(t, s, vars.Mappable()); err != nil {
+		t.Fatal(err)
+	}
+
+	created := make(chan error, 1)
+	go func() {
+		_, err := s.Create(context.Background(), &pulumirpc.CreateRequest{Urn: urn})
+		created <- err
+	}()
+	<-inner.started
+	defer close(inner.release)
+
+	oidc := s.guard.oidc.Load()
+	now := time.Now().Add(50 * time.Minute)
+	oidc.mu.Lock()
+	oidc.now = func() time.Time { return now }
+	oidc.mu.Unlock()
+
+	read := make(chan error, 1)
+	go func() {
+		_, err := s.Read(context.Background(), &pulumirpc.ReadRequest{Urn: urn, Id: "c9q1"})
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the refresh waited for the create in flight")
+	}
+
+	inner.mu.Lock()
+	configured := inner.configured
+	inner.mu.Unlock()
+	if configured != 2 {
+		t.Errorf("upstream was configured %d times, want a reconfiguration while the create ran", configured)
+	}
+	select {
+	case err := <-created:
+		t.Fatalf("the create finished before it was released: %v", err)
+	default:
+	}
+}
+
+func TestOIDCCredentialsCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		vars map[string]interface{}
+		want string
+	}{
+		{
+			name: "no service account",
+			vars: map[string]interface{}{"oidcTokenFile": "/var/run/token"},
+			want: "yandex:serviceAccountId (YC_SERVICE_ACCOUNT_ID) is required",
+		},
+		{
+			name: "token and OIDC",
+			vars: map[string]interface{}{"token": "t1", "oidcTokenEnv": "CI_JOB_JWT", "serviceAccountId": "ajesa"},
+			want: "only one credential source",
+		},
+		{
+			name: "file and env",
+			vars: map[string]interface{}{
+				"oidcTokenFile": "/var/run/token", "oidcTokenEnv": "CI_JOB_JWT", "serviceAccountId": "ajesa",
+			},
+			want: "only one of yandex:oidcTokenFile and yandex:oidcTokenEnv",
+		},
+	}
+	t.Setenv(folderIDEnv, "b1gfolder")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCredentials(context.Background(), nil, resource.NewPropertyMapFromMap(tt.vars), nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("got error %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
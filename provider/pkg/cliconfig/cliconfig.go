@@ -0,0 +1,102 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cliconfig reads the active profile of the yc CLI so that developers who already use
+// `yc` can run the provider without any extra setup.
+package cliconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is the subset of a yc CLI profile the provider understands.
+type Profile struct {
+	Token             string         `yaml:"token"`
+	ServiceAccountKey map[string]any `yaml:"service-account-key"`
+	CloudID           string         `yaml:"cloud-id"`
+	FolderID          string         `yaml:"folder-id"`
+	OrganizationID    string         `yaml:"organization-id"`
+	Zone              string         `yaml:"compute-default-zone"`
+	Endpoint          string         `yaml:"endpoint"`
+}
+
+type file struct {
+	Current  string              `yaml:"current"`
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// DefaultPath returns the location of the yc CLI config file, ~/.config/yandex-cloud/config.yaml.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "yandex-cloud", "config.yaml"), nil
+}
+
+// Load reads the active profile from the yc CLI config file at path. It returns a nil profile
+// and no error when the file does not exist.
+func Load(path string) (*Profile, error) {
+	contents, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f file
+	if err := yaml.Unmarshal(contents, &f); err != nil {
+		return nil, fmt.Errorf("cannot parse yc CLI config %s: %w", path, err)
+	}
+	if f.Current == "" {
+		return nil, nil
+	}
+	profile, ok := f.Profiles[f.Current]
+	if !ok {
+		return nil, fmt.Errorf("yc CLI config %s: active profile %q is not defined", path, f.Current)
+	}
+	return profile, nil
+}
+
+// Env returns the profile's values keyed by the provider environment variables they stand in for.
+func (p *Profile) Env() (map[string]string, error) {
+	env := map[string]string{}
+	set := func(name, value string) {
+		if value != "" {
+			env[name] = value
+		}
+	}
+
+	set("YC_TOKEN", p.Token)
+	if len(p.ServiceAccountKey) != 0 {
+		// The provider accepts the contents of a key file as well as a path to one.
+		key, err := json.Marshal(p.ServiceAccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode service account key: %w", err)
+		}
+		set("YC_SERVICE_ACCOUNT_KEY_FILE", string(key))
+	}
+	set("YC_CLOUD_ID", p.CloudID)
+	set("YC_FOLDER_ID", p.FolderID)
+	set("YC_ORGANIZATION_ID", p.OrganizationID)
+	set("YC_ZONE", p.Zone)
+	set("YC_ENDPOINT", p.Endpoint)
+	return env, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cliconfig
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadActiveProfile(t *testing.T) {
+	profile, err := Load(filepath.Join("testdata", "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile == nil {
+		t.Fatal("expected the active profile to be loaded")
+	}
+	if profile.FolderID != "b1gdevfolder" || profile.Zone != "ru-central1-b" {
+		t.Errorf("loaded the wrong profile: %+v", profile)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	profile, err := Load(filepath.Join(t.TempDir(), "config.yaml"))
+	if err != nil || profile != nil {
+		t.Errorf("expected no profile and no error, got %+v, %v", profile, err)
+	}
+}
+
+func TestProfileEnv(t *testing.T) {
+	profile, err := Load(filepath.Join("testdata", "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err := profile.Env()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := env["YC_CLOUD_ID"]; got != "b1gdevcloud" {
+		t.Errorf("YC_CLOUD_ID: got %q", got)
+	}
+	if _, ok := env["YC_TOKEN"]; ok {
+		t.Errorf("the profile has no token, got %q", env["YC_TOKEN"])
+	}
+	var key map[string]any
+	if err := json.Unmarshal([]byte(env["YC_SERVICE_ACCOUNT_KEY_FILE"]), &key); err != nil {
+		t.Fatalf("YC_SERVICE_ACCOUNT_KEY_FILE is not a JSON key: %v", err)
+	}
+	if key["service_account_id"] != "ajesaid" {
+		t.Errorf("unexpected key: %v", key)
+	}
+}
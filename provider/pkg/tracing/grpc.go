@@ -0,0 +1,108 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	apiPrefix        = "/yandex.cloud."
+	operationMessage = "yandex.cloud.operation.Operation"
+)
+
+// DialOptions returns the gRPC dial options that trace Yandex Cloud API calls. The upstream
+// provider adds them to the connections of the Yandex Cloud SDK clients it builds.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(unaryClientInterceptor)}
+}
+
+// unaryClientInterceptor traces Yandex Cloud API calls as children of the span in ctx. Calls that
+// start a long-running operation, and polls of one, are tagged with the operation ID; the
+// operations a provider call starts are also recorded as events on its span.
+func unaryClientInterceptor(
+	ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if !strings.HasPrefix(method, apiPrefix) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	name := strings.TrimPrefix(method, "/")
+	service, rpc, _ := strings.Cut(name, "/")
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", rpc),
+	}
+	if cc != nil {
+		attrs = append(attrs, attribute.String("server.address", cc.Target()))
+	}
+	poll, isPoll := req.(interface{ GetOperationId() string })
+	if isPoll && service == "yandex.cloud.operation.OperationService" {
+		attrs = append(attrs, OperationIDKey.String(poll.GetOperationId()))
+	} else {
+		isPoll = false
+	}
+
+	parent := trace.SpanFromContext(ctx)
+	ctx, span := tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if id, done, ok := operationOf(reply); err == nil && ok {
+		span.SetAttributes(OperationIDKey.String(id), attribute.Bool("yandex.operation_done", done))
+		if !isPoll {
+			parent.AddEvent("operation started", trace.WithAttributes(
+				OperationIDKey.String(id),
+				attribute.String("rpc.method", name),
+			))
+		}
+	}
+	End(span, err)
+	return err
+}
+
+// operationOf returns the ID and state of reply when it is a yandex.cloud.operation.Operation.
+// It relies on protobuf reflection so the provider need not depend on the Yandex Cloud SDK.
+func operationOf(reply any) (id string, done, ok bool) {
+	m, isProto := reply.(proto.Message)
+	if !isProto || m == nil {
+		return "", false, false
+	}
+	msg := m.ProtoReflect()
+	if !msg.IsValid() || msg.Descriptor().FullName() != operationMessage {
+		return "", false, false
+	}
+	fields := msg.Descriptor().Fields()
+	get := func(name protoreflect.Name) protoreflect.Value {
+		if fd := fields.ByName(name); fd != nil {
+			return msg.Get(fd)
+		}
+		return protoreflect.Value{}
+	}
+	if v := get("id"); v.IsValid() {
+		id = v.String()
+	}
+	if v := get("done"); v.IsValid() {
+		done = v.Bool()
+	}
+	return id, done, true
+}
@@ -0,0 +1,179 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing exports OpenTelemetry traces of provider operations and the Yandex Cloud API
+// calls they make. Tracing is off unless one of the environment variables below is set when the
+// engine starts the provider:
+//
+//   - YC_TRACES_FILE writes OTLP/JSON, one export request per line, to the given file. The format
+//     is the one read by the OpenTelemetry Collector's otlpjsonfile receiver.
+//   - OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT sends OTLP over gRPC to a
+//     collector. The other standard OTEL_EXPORTER_OTLP_* and OTEL_RESOURCE_ATTRIBUTES variables
+//     apply as well.
+//
+// API calls are traced by the interceptor DialOptions returns, which the upstream provider is
+// given when it is created.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/airoh-io/pulumi-yandex/provider/pkg/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FileEnv names the file traces are written to.
+const FileEnv = "YC_TRACES_FILE"
+
+const (
+	instrumentationName = "github.com/airoh-io/pulumi-yandex/provider"
+	serviceName         = "pulumi-resource-yandex"
+)
+
+// Span attributes set by the provider.
+const (
+	ResourceTypeKey = attribute.Key("yandex.resource_type")
+	URNKey          = attribute.Key("pulumi.urn")
+	OperationKey    = attribute.Key("pulumi.operation")
+	OperationIDKey  = attribute.Key("yandex.operation_id")
+	RetriesKey      = attribute.Key("yandex.retries")
+)
+
+// Start installs the exporters selected by the environment. The returned function flushes and
+// stops the exporters; it is a no-op when tracing is off or could not be set up.
+func Start(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	noop := func(context.Context) error { return nil }
+
+	var exporters []sdktrace.SpanExporter
+	if path := os.Getenv(FileEnv); path != "" {
+		exp, err := otlptrace.New(ctx, &fileClient{path: path})
+		if err != nil {
+			return noop, fmt.Errorf("%s: %w", FileEnv, err)
+		}
+		exporters = append(exporters, exp)
+	}
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" {
+		exp, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return noop, fmt.Errorf("OTLP exporter: %w", err)
+		}
+		exporters = append(exporters, exp)
+	}
+	if len(exporters) == 0 {
+		return noop, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version.Version),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	// The engine may kill the provider without shutting it down, so spans are exported as soon as
+	// they end rather than batched.
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	for _, exp := range exporters {
+		opts = append(opts, sdktrace.WithSyncer(exp))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartOperation starts the span of a provider operation (create, read, update, delete or invoke)
+// on a resource or function with the Pulumi type token tok. urn is empty for invokes.
+func StartOperation(ctx context.Context, op, tok, urn string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{OperationKey.String(op), ResourceTypeKey.String(tok)}
+	if urn != "" {
+		attrs = append(attrs, URNKey.String(urn))
+	}
+	return tracer().Start(ctx, op+" "+tok, trace.WithAttributes(attrs...))
+}
+
+// End records err, if any, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// fileClient is an OTLP client that appends export requests to a file as JSON lines.
+type fileClient struct {
+	path string
+
+	mu sync.Mutex
+	f  *os.File
+}
+
+func (c *fileClient) Start(context.Context) error {
+	f, err := os.OpenFile(c.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.f = f
+	return nil
+}
+
+func (c *fileClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.f == nil {
+		return nil
+	}
+	err := c.f.Close()
+	c.f = nil
+	return err
+}
+
+func (c *fileClient) UploadTraces(_ context.Context, spans []*tracepb.ResourceSpans) error {
+	line, err := protojson.Marshal(&coltracepb.ExportTraceServiceRequest{ResourceSpans: spans})
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.f == nil {
+		return errors.New("trace file is closed")
+	}
+	_, err = c.f.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,254 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// operation builds a yandex.cloud.operation.Operation without depending on the Yandex Cloud SDK.
+func operation(t *testing.T, id string, done bool) *dynamicpb.Message {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("yandex/cloud/operation/operation.proto"),
+		Package: proto.String("yandex.cloud.operation"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Operation"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name: proto.String("id"), JsonName: proto.String("id"), Number: proto.Int32(1),
+					Type:  descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				},
+				{
+					Name: proto.String("done"), JsonName: proto.String("done"), Number: proto.Int32(6),
+					Type:  descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+					Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				},
+			},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := fd.Messages().ByName("Operation")
+	m := dynamicpb.NewMessage(md)
+	m.Set(md.Fields().ByName("id"), protoreflect.ValueOfString(id))
+	m.Set(md.Fields().ByName("done"), protoreflect.ValueOfBool(done))
+	return m
+}
+
+type getOperationRequest struct{ id string }
+
+func (r getOperationRequest) GetOperationId() string { return r.id }
+
+func invoke(ctx context.Context, method string, req, reply any) error {
+	return unaryClientInterceptor(ctx, method, req, reply, nil,
+		func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error { return nil })
+}
+
+// startFileTraces starts tracing to a file and returns the function that flushes it.
+func startFileTraces(t *testing.T, path string) func(context.Context) error {
+	t.Helper()
+	t.Setenv(FileEnv, path)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	shutdown, err := Start(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shutdown
+}
+
+func TestFileTraces(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	shutdown := startFileTraces(t, path)
+	ctx := context.Background()
+
+	const (
+		urn = "urn:pulumi:prod::infra::yandex:mdb/postgresqlCluster:PostgresqlCluster::db"
+		tok = "yandex:mdb/postgresqlCluster:PostgresqlCluster"
+	)
+	opCtx, span := StartOperation(ctx, "update", tok, urn)
+	must(t, invoke(opCtx, "/yandex.cloud.mdb.postgresql.v1.ClusterService/Update", nil, operation(t, "mdb1op", false)))
+	must(t, invoke(opCtx, "/yandex.cloud.operation.OperationService/Get", getOperationRequest{"mdb1op"},
+		operation(t, "mdb1op", true)))
+	must(t, invoke(opCtx, "/pulumirpc.Engine/Log", nil, nil))
+	End(span, errors.New("quota exceeded"))
+	must(t, shutdown(ctx))
+
+	spans := readSpans(t, path)
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3: %v", len(spans), spans)
+	}
+	root := spans["update "+tok]
+	update := spans["yandex.cloud.mdb.postgresql.v1.ClusterService/Update"]
+	poll := spans["yandex.cloud.operation.OperationService/Get"]
+	if root == nil || update == nil || poll == nil {
+		t.Fatalf("missing spans: %v", spans)
+	}
+
+	if got := attr(root, string(URNKey)); got != urn {
+		t.Errorf("operation span has urn %q, want %q", got, urn)
+	}
+	if got := attr(root, string(ResourceTypeKey)); got != tok {
+		t.Errorf("operation span has resource type %q, want %q", got, tok)
+	}
+	if got := root.GetStatus().GetMessage(); got != "quota exceeded" {
+		t.Errorf("operation span has status %q, want the error", got)
+	}
+	var started int
+	for _, e := range root.Events {
+		if e.Name == "operation started" {
+			started++
+		}
+	}
+	if started != 1 {
+		t.Errorf("operation span events: %v", root.Events)
+	}
+	for _, s := range []*tracepb.Span{update, poll} {
+		if string(s.ParentSpanId) != string(root.SpanId) {
+			t.Errorf("%s is not a child of the operation span", s.Name)
+		}
+		if got := attr(s, string(OperationIDKey)); got != "mdb1op" {
+			t.Errorf("%s has operation ID %q, want mdb1op", s.Name, got)
+		}
+	}
+}
+
+// TestDialOptions makes a Yandex Cloud API call over a connection dialed with DialOptions, as the
+// upstream provider does, and checks that it is traced as a child of the operation span.
+func TestDialOptions(t *testing.T) {
+	reply := operation(t, "enpop1", false)
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+			return err
+		}
+		return stream.SendMsg(reply)
+	}))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	opts := append(DialOptions(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+	)
+	conn, err := grpc.NewClient("passthrough:///vpc.api.cloud.yandex.net", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	shutdown := startFileTraces(t, path)
+	ctx := context.Background()
+
+	const tok = "yandex:vpc/network:Network"
+	opCtx, span := StartOperation(ctx, "create", tok, "")
+	got := operation(t, "", false)
+	must(t, conn.Invoke(opCtx, "/yandex.cloud.vpc.v1.NetworkService/Create", &emptypb.Empty{}, got))
+	End(span, nil)
+	must(t, shutdown(ctx))
+
+	spans := readSpans(t, path)
+	root, call := spans["create "+tok], spans["yandex.cloud.vpc.v1.NetworkService/Create"]
+	if root == nil || call == nil {
+		t.Fatalf("missing spans: %v", spans)
+	}
+	if string(call.ParentSpanId) != string(root.SpanId) {
+		t.Errorf("the API call is not a child of the operation span")
+	}
+	if got := attr(call, string(OperationIDKey)); got != "enpop1" {
+		t.Errorf("the API call has operation ID %q, want enpop1", got)
+	}
+}
+
+func TestOperationOf(t *testing.T) {
+	if id, done, ok := operationOf(operation(t, "op1", true)); !ok || id != "op1" || !done {
+		t.Errorf("got %q, %v, %v", id, done, ok)
+	}
+	if _, _, ok := operationOf(&descriptorpb.FileDescriptorProto{}); ok {
+		t.Errorf("treated another message as an operation")
+	}
+	if _, _, ok := operationOf(nil); ok {
+		t.Errorf("treated nil as an operation")
+	}
+}
+
+func readSpans(t *testing.T, path string) map[string]*tracepb.Span {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	spans := map[string]*tracepb.Span{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var req coltracepb.ExportTraceServiceRequest
+		if err := protojson.Unmarshal(scanner.Bytes(), &req); err != nil {
+			t.Fatalf("line is not an OTLP/JSON export request: %v", err)
+		}
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					spans[s.Name] = s
+				}
+			}
+		}
+	}
+	must(t, scanner.Err())
+	return spans
+}
+
+func attr(s *tracepb.Span, key string) string {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return kv.Value.GetStringValue()
+		}
+	}
+	return ""
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	readOnlyKey = "readOnly"
+	readOnlyEnv = "YC_READ_ONLY"
+)
+
+// readOnlyConfig is the Pulumi-only readOnly provider option.
+var readOnlyConfig = &tfbridge.ConfigInfo{
+	Schema: (&schema.Schema{
+		Type:     shim.TypeBool,
+		Optional: true,
+		Description: "Refuse to create, update or delete resources. Preview, refresh and invokes keep working. " +
+			"Can also be set with the YC_READ_ONLY environment variable.",
+	}).Shim(),
+}
+
+// readOnlyEnabled reads the readOnly option, falling back to YC_READ_ONLY when it is not set.
+func readOnlyEnabled(vars resource.PropertyMap) (bool, error) {
+	on, set, err := configBool(vars, readOnlyKey)
+	if err != nil || set {
+		return on, err
+	}
+
+	if env := os.Getenv(readOnlyEnv); env != "" {
+		on, err := strconv.ParseBool(env)
+		if err != nil {
+			return false, checkFailure(readOnlyKey, "%s must be a boolean, got %q", readOnlyEnv, env)
+		}
+		return on, nil
+	}
+	return false, nil
+}
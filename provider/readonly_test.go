@@ -0,0 +1,112 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// TestReadOnlyServer runs the mutating calls of Managed OpenSearch, which the plugin-framework half
+// of the upstream provider serves, through the guarded server.
+func TestReadOnlyServer(t *testing.T) {
+	const urn = "urn:pulumi:prod::infra::yandex:mdb/opensearchCluster:OpensearchCluster::search"
+	ctx := context.Background()
+	t.Setenv(readOnlyEnv, "")
+
+	inner := &recordingServer{}
+	s := newGuardedServer(inner, &providerGuard{}, nil)
+	if err := configureServer(t, s, map[string]interface{}{"folderId": "b1gdev"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(ctx, &pulumirpc.CreateRequest{Urn: urn}); err != nil {
+		t.Fatalf("create failed with read-only mode off: %v", err)
+	}
+
+	if err := configureServer(t, s, map[string]interface{}{"folderId": "b1gdev", "readOnly": true}); err != nil {
+		t.Fatal(err)
+	}
+	inner.calls = nil
+	calls := map[string]func() error{
+		"create": func() error {
+			_, err := s.Create(ctx, &pulumirpc.CreateRequest{Urn: urn})
+			return err
+		},
+		"update": func() error {
+			_, err := s.Update(ctx, &pulumirpc.UpdateRequest{Urn: urn, Id: "c9q1"})
+			return err
+		},
+		"delete": func() error {
+			_, err := s.Delete(ctx, &pulumirpc.DeleteRequest{Urn: urn, Id: "c9q1"})
+			return err
+		},
+	}
+	for op, call := range calls {
+		err := call()
+		if err == nil {
+			t.Fatalf("%s succeeded in read-only mode", op)
+		}
+		if want := "refusing to " + op + " " + urn; !strings.Contains(err.Error(), want) {
+			t.Errorf("got error %q, want it to contain %q", err, want)
+		}
+	}
+	if len(inner.calls) != 0 {
+		t.Errorf("%v reached the provider in read-only mode", inner.calls)
+	}
+
+	// Previews don't touch the cloud and keep working.
+	if _, err := s.Create(ctx, &pulumirpc.CreateRequest{Urn: urn, Preview: true}); err != nil {
+		t.Errorf("create preview failed in read-only mode: %v", err)
+	}
+	if _, err := s.Update(ctx, &pulumirpc.UpdateRequest{Urn: urn, Id: "c9q1", Preview: true}); err != nil {
+		t.Errorf("update preview failed in read-only mode: %v", err)
+	}
+}
+
+func TestReadOnlyEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		vars map[string]interface{}
+		env  string
+		want bool
+	}{
+		{name: "unset"},
+		{name: "config", vars: map[string]interface{}{"readOnly": true}, want: true},
+		{name: "config as string", vars: map[string]interface{}{"readOnly": "true"}, want: true},
+		{name: "env", env: "1", want: true},
+		{name: "config wins over env", vars: map[string]interface{}{"readOnly": false}, env: "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(readOnlyEnv, tt.env)
+			got, err := readOnlyEnabled(resource.NewPropertyMapFromMap(tt.vars))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Setenv(readOnlyEnv, "sometimes")
+	if _, err := readOnlyEnabled(resource.PropertyMap{}); err == nil {
+		t.Errorf("expected an error for a malformed %s", readOnlyEnv)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	rprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// regionProfile holds the endpoints and zones of a Yandex Cloud region. Endpoints are keyed by
+// the provider option they fill in.
+type regionProfile struct {
+	endpoints map[resource.PropertyKey]string
+	zones     []string
+}
+
+const (
+	regionIDEnv = "YC_REGION_ID"
+	zoneEnv     = "YC_ZONE"
+)
+
+// endpointEnvs are the environment variables that set the endpoints a region profile fills in.
+var endpointEnvs = map[resource.PropertyKey]string{
+	"endpoint":        "YC_ENDPOINT",
+	"storageEndpoint": "YC_STORAGE_ENDPOINT_URL",
+	"ymqEndpoint":     "YC_MESSAGE_QUEUE_ENDPOINT",
+	"yqEndpoint":      "YC_YQ_ENDPOINT",
+}
+
+// regionProfiles are selected with yandex:regionId.
+var regionProfiles = map[string]regionProfile{
+	"ru-central1": {
+		endpoints: map[resource.PropertyKey]string{
+			"endpoint":        "api.cloud.yandex.net:443",
+			"storageEndpoint": "storage.yandexcloud.net",
+			"ymqEndpoint":     "message-queue.api.cloud.yandex.net",
+			"yqEndpoint":      "grpcs://grpc.yandex-query.cloud.yandex.net:2135",
+		},
+		zones: []string{"ru-central1-a", "ru-central1-b", "ru-central1-d"},
+	},
+	"kz1": {
+		endpoints: map[resource.PropertyKey]string{
+			"endpoint":        "api.yandexcloud.kz:443",
+			"storageEndpoint": "storage.yandexcloud.kz",
+			"ymqEndpoint":     "message-queue.api.yandexcloud.kz",
+			"yqEndpoint":      "grpcs://grpc.yandex-query.yandexcloud.kz:2135",
+		},
+		zones: []string{"kz1-a"},
+	},
+}
+
+// applyRegionProfile fills the endpoints yandex:regionId (YC_REGION_ID) implies into the provider
+// configuration. Endpoints that are set explicitly, in the configuration or the environment, win
+// unless they belong to another region; so does a zone of another region. Regions without a
+// profile are left to the upstream provider.
+func applyRegionProfile(_ context.Context, _ *rprovider.HostClient, vars resource.PropertyMap, _ shim.ResourceConfig) error {
+	region := configStringOrEnv(vars, "regionId", regionIDEnv)
+	profile, ok := regionProfiles[region]
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(profile.endpoints))
+	for k := range profile.endpoints {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		key := resource.PropertyKey(k)
+		explicit := configStringOrEnv(vars, key, endpointEnvs[key])
+		if explicit == "" {
+			vars[key] = resource.NewStringProperty(profile.endpoints[key])
+			continue
+		}
+		if other := endpointRegion(key, explicit); other != "" && other != region {
+			return checkFailure(k, "yandex:%s %q is the endpoint of region %s, but yandex:regionId is %s",
+				key, explicit, other, region)
+		}
+	}
+
+	if zone := configStringOrEnv(vars, "zone", zoneEnv); zone != "" {
+		if err := profile.checkZone(region, zone); err != nil {
+			return checkFailure("zone", "yandex:zone: %v", err)
+		}
+	}
+	return nil
+}
+
+// checkZone fails when zone belongs to another region. Zones no profile knows are not checked:
+// the upstream provider reports those itself.
+func (p regionProfile) checkZone(region, zone string) error {
+	other := zoneRegion(zone)
+	if other == "" || other == region {
+		return nil
+	}
+	return fmt.Errorf("zone %q is in region %s, but yandex:regionId is %s (zones %s)",
+		zone, other, region, strings.Join(p.zones, ", "))
+}
+
+// endpointRegion returns the region whose profile uses value for key, ignoring the scheme and
+// default port.
+func endpointRegion(key resource.PropertyKey, value string) string {
+	for region, profile := range regionProfiles {
+		if endpoint, ok := profile.endpoints[key]; ok && endpointHost(endpoint) == endpointHost(value) {
+			return region
+		}
+	}
+	return ""
+}
+
+func endpointHost(endpoint string) string {
+	if _, rest, ok := strings.Cut(endpoint, "://"); ok {
+		endpoint = rest
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	return strings.TrimSuffix(endpoint, ":443")
+}
+
+func zoneRegion(zone string) string {
+	for region, profile := range regionProfiles {
+		for _, z := range profile.zones {
+			if z == zone {
+				return region
+			}
+		}
+	}
+	return ""
+}
+
+// applyRegionZones checks that resources are not placed in a zone of another region than the one
+// yandex:regionId selects.
+func applyRegionZones(prov *tfbridge.ProviderInfo) {
+	eachResourceWith(prov, "zone", func(_ string, info *tfbridge.ResourceInfo, sch shim.Schema) {
+		if sch.Type() == shim.TypeString {
+			info.PreCheckCallback = chainPreCheck(info.PreCheckCallback, checkRegionZone)
+		}
+	})
+}
+
+func checkRegionZone(ctx context.Context, config, meta resource.PropertyMap) (resource.PropertyMap, error) {
+	region := configStringOrEnv(meta, "regionId", regionIDEnv)
+	profile, ok := regionProfiles[region]
+	if !ok {
+		return config, nil
+	}
+	if zone := configString(config, "zone"); zone != "" {
+		if err := profile.checkZone(region, zone); err != nil {
+			return nil, fmt.Errorf("%s: %w", contextURN(ctx, "resource"), err)
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestApplyRegionProfile(t *testing.T) {
+	t.Setenv(regionIDEnv, "")
+	t.Setenv(zoneEnv, "")
+	for _, env := range endpointEnvs {
+		t.Setenv(env, "")
+	}
+
+	tests := []struct {
+		name    string
+		vars    map[string]interface{}
+		env     map[string]string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "kz1",
+			vars: map[string]interface{}{"regionId": "kz1", "zone": "kz1-a"},
+			want: map[string]string{
+				"endpoint":        "api.yandexcloud.kz:443",
+				"storageEndpoint": "storage.yandexcloud.kz",
+				"ymqEndpoint":     "message-queue.api.yandexcloud.kz",
+				"yqEndpoint":      "grpcs://grpc.yandex-query.yandexcloud.kz:2135",
+			},
+		},
+		{
+			name: "explicit override wins",
+			vars: map[string]interface{}{"regionId": "ru-central1", "endpoint": "api.private.example:443"},
+			want: map[string]string{
+				"endpoint":        "api.private.example:443",
+				"storageEndpoint": "storage.yandexcloud.net",
+			},
+		},
+		{
+			name: "same endpoint in another form",
+			vars: map[string]interface{}{"regionId": "kz1", "storageEndpoint": "https://storage.yandexcloud.kz/"},
+			want: map[string]string{"storageEndpoint": "https://storage.yandexcloud.kz/"},
+		},
+		{
+			name:    "endpoint of another region",
+			vars:    map[string]interface{}{"regionId": "kz1", "endpoint": "api.cloud.yandex.net:443"},
+			wantErr: `yandex:endpoint "api.cloud.yandex.net:443" is the endpoint of region ru-central1, but yandex:regionId is kz1`,
+		},
+		{
+			name:    "zone of another region",
+			vars:    map[string]interface{}{"regionId": "kz1", "zone": "ru-central1-a"},
+			wantErr: `zone "ru-central1-a" is in region ru-central1, but yandex:regionId is kz1 (zones kz1-a)`,
+		},
+		{
+			name: "region from the environment",
+			env:  map[string]string{regionIDEnv: "kz1"},
+			want: map[string]string{"endpoint": "api.yandexcloud.kz:443", "storageEndpoint": "storage.yandexcloud.kz"},
+		},
+		{
+			name: "config wins over the environment",
+			vars: map[string]interface{}{"regionId": "ru-central1"},
+			env:  map[string]string{regionIDEnv: "kz1"},
+			want: map[string]string{"endpoint": "api.cloud.yandex.net:443"},
+		},
+		{
+			name: "endpoint from the environment",
+			env:  map[string]string{regionIDEnv: "kz1", "YC_ENDPOINT": "api.private.example:443"},
+			want: map[string]string{"endpoint": "", "storageEndpoint": "storage.yandexcloud.kz"},
+		},
+		{
+			name:    "zone from the environment",
+			env:     map[string]string{regionIDEnv: "kz1", zoneEnv: "ru-central1-a"},
+			wantErr: `zone "ru-central1-a" is in region ru-central1, but yandex:regionId is kz1`,
+		},
+		{
+			name: "unknown region",
+			vars: map[string]interface{}{"regionId": "il1"},
+			want: map[string]string{"endpoint": ""},
+		},
+		{
+			name: "no region",
+			vars: map[string]interface{}{"zone": "kz1-a"},
+			want: map[string]string{"endpoint": ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			vars := resource.NewPropertyMapFromMap(tt.vars)
+			err := applyRegionProfile(context.Background(), nil, vars, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, want := range tt.want {
+				if got := configString(vars, resource.PropertyKey(k)); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckRegionZone(t *testing.T) {
+	t.Setenv(regionIDEnv, "")
+	meta := resource.NewPropertyMapFromMap(map[string]interface{}{"regionId": "kz1"})
+	tests := []struct {
+		zone    string
+		wantErr bool
+	}{
+		{zone: "kz1-a"},
+		{zone: "ru-central1-b", wantErr: true},
+		// DNS zones share the field name and are never mistaken for availability zones.
+		{zone: "example.com."},
+	}
+	for _, tt := range tests {
+		config := resource.NewPropertyMapFromMap(map[string]interface{}{"zone": tt.zone})
+		_, err := checkRegionZone(context.Background(), config, meta)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("zone %q: got error %v, want error %v", tt.zone, err, tt.wantErr)
+		}
+	}
+
+	t.Setenv(regionIDEnv, "kz1")
+	config := resource.NewPropertyMapFromMap(map[string]interface{}{"zone": "ru-central1-b"})
+	if _, err := checkRegionZone(context.Background(), config, resource.PropertyMap{}); err == nil {
+		t.Errorf("zone of another region than %s=kz1 passed", regionIDEnv)
+	}
+}
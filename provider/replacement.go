@@ -0,0 +1,208 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// uniqueNameFields classifies every resource by the field whose value must be unique within the
+// resource's parent: the folder, cloud, cluster, registry, bucket and so on. Replacing such a
+// resource without changing that field fails when the replacement is created first, because its
+// name collides with the object it replaces. Resources that have no name, or whose names may
+// repeat, map to "". IAM policy, binding and member resources have no name and are left out. New
+// resources must be added here; a test checks the table is complete.
+var uniqueNameFields = map[string]string{
+	"yandex_airflow_cluster":                                  "name",
+	"yandex_alb_backend_group":                                "name",
+	"yandex_alb_http_router":                                  "name",
+	"yandex_alb_load_balancer":                                "name",
+	"yandex_alb_target_group":                                 "name",
+	"yandex_alb_virtual_host":                                 "name",
+	"yandex_api_gateway":                                      "name",
+	"yandex_audit_trails_trail":                               "name",
+	"yandex_backup_policy":                                    "name",
+	"yandex_backup_policy_bindings":                           "",
+	"yandex_cdn_origin_group":                                 "name",
+	"yandex_cdn_resource":                                     "cname",
+	"yandex_cm_certificate":                                   "name",
+	"yandex_compute_disk":                                     "name",
+	"yandex_compute_disk_placement_group":                     "name",
+	"yandex_compute_filesystem":                               "name",
+	"yandex_compute_gpu_cluster":                              "name",
+	"yandex_compute_image":                                    "name",
+	"yandex_compute_instance":                                 "name",
+	"yandex_compute_instance_group":                           "name",
+	"yandex_compute_placement_group":                          "name",
+	"yandex_compute_snapshot":                                 "name",
+	"yandex_compute_snapshot_schedule":                        "name",
+	"yandex_container_registry":                               "name",
+	"yandex_container_registry_ip_permission":                 "",
+	"yandex_container_repository":                             "name",
+	"yandex_container_repository_lifecycle_policy":            "name",
+	"yandex_dataproc_cluster":                                 "name",
+	"yandex_datatransfer_endpoint":                            "name",
+	"yandex_datatransfer_transfer":                            "name",
+	"yandex_dns_recordset":                                    "name",
+	"yandex_dns_zone":                                         "name",
+	"yandex_function":                                         "name",
+	"yandex_function_scaling_policy":                          "",
+	"yandex_function_trigger":                                 "name",
+	"yandex_iam_service_account":                              "name",
+	"yandex_iam_service_account_api_key":                      "",
+	"yandex_iam_service_account_key":                          "",
+	"yandex_iam_service_account_static_access_key":            "",
+	"yandex_iam_workload_identity_federated_credential":       "",
+	"yandex_iam_workload_identity_oidc_federation":            "name",
+	"yandex_iot_core_broker":                                  "name",
+	"yandex_iot_core_device":                                  "name",
+	"yandex_iot_core_registry":                                "name",
+	"yandex_kms_asymmetric_encryption_key":                    "name",
+	"yandex_kms_asymmetric_signature_key":                     "name",
+	"yandex_kms_secret_ciphertext":                            "",
+	"yandex_kms_symmetric_key":                                "name",
+	"yandex_kubernetes_cluster":                               "name",
+	"yandex_kubernetes_node_group":                            "name",
+	"yandex_lb_network_load_balancer":                         "name",
+	"yandex_lb_target_group":                                  "name",
+	"yandex_loadtesting_agent":                                "name",
+	"yandex_lockbox_secret":                                   "name",
+	"yandex_lockbox_secret_version":                           "",
+	"yandex_lockbox_secret_version_hashed":                    "",
+	"yandex_logging_group":                                    "name",
+	"yandex_mdb_clickhouse_cluster":                           "name",
+	"yandex_mdb_clickhouse_database":                          "name",
+	"yandex_mdb_clickhouse_user":                              "name",
+	"yandex_mdb_greenplum_cluster":                            "name",
+	"yandex_mdb_kafka_cluster":                                "name",
+	"yandex_mdb_kafka_connector":                              "name",
+	"yandex_mdb_kafka_topic":                                  "name",
+	"yandex_mdb_kafka_user":                                   "name",
+	"yandex_mdb_mongodb_cluster":                              "name",
+	"yandex_mdb_mongodb_database":                             "name",
+	"yandex_mdb_mongodb_user":                                 "name",
+	"yandex_mdb_mysql_cluster":                                "name",
+	"yandex_mdb_mysql_cluster_v2":                             "name",
+	"yandex_mdb_mysql_database":                               "name",
+	"yandex_mdb_mysql_user":                                   "name",
+	"yandex_mdb_opensearch_cluster":                           "name",
+	"yandex_mdb_postgresql_cluster":                           "name",
+	"yandex_mdb_postgresql_cluster_v2":                        "name",
+	"yandex_mdb_postgresql_database":                          "name",
+	"yandex_mdb_postgresql_user":                              "name",
+	"yandex_mdb_redis_cluster":                                "name",
+	"yandex_mdb_redis_user":                                   "name",
+	"yandex_mdb_sqlserver_cluster":                            "name",
+	"yandex_message_queue":                                    "name",
+	"yandex_metastore_cluster":                                "name",
+	"yandex_monitoring_dashboard":                             "name",
+	"yandex_organizationmanager_group":                        "name",
+	"yandex_organizationmanager_group_mapping":                "",
+	"yandex_organizationmanager_group_mapping_item":           "",
+	"yandex_organizationmanager_group_membership":             "",
+	"yandex_organizationmanager_os_login_settings":            "",
+	"yandex_organizationmanager_saml_federation":              "name",
+	"yandex_organizationmanager_saml_federation_user_account": "name_id",
+	"yandex_organizationmanager_user_ssh_key":                 "",
+	"yandex_resourcemanager_cloud":                            "name",
+	"yandex_resourcemanager_folder":                           "name",
+	"yandex_serverless_container":                             "name",
+	"yandex_serverless_eventrouter_bus":                       "name",
+	"yandex_serverless_eventrouter_connector":                 "name",
+	"yandex_serverless_eventrouter_rule":                      "name",
+	"yandex_smartcaptcha_captcha":                             "name",
+	"yandex_spark_cluster":                                    "name",
+	"yandex_storage_bucket":                                   "bucket",
+	"yandex_storage_object":                                   "key",
+	"yandex_sws_advanced_rate_limiter_profile":                "name",
+	"yandex_sws_security_profile":                             "name",
+	"yandex_sws_waf_profile":                                  "name",
+	"yandex_trino_catalog":                                    "name",
+	"yandex_trino_cluster":                                    "name",
+	"yandex_vpc_address":                                      "name",
+	"yandex_vpc_default_security_group":                       "",
+	"yandex_vpc_gateway":                                      "name",
+	"yandex_vpc_network":                                      "name",
+	"yandex_vpc_private_endpoint":                             "name",
+	"yandex_vpc_route_table":                                  "name",
+	"yandex_vpc_security_group":                               "name",
+	"yandex_vpc_security_group_rule":                          "",
+	"yandex_vpc_subnet":                                       "name",
+	"yandex_ydb_database_dedicated":                           "name",
+	"yandex_ydb_database_serverless":                          "name",
+	"yandex_ydb_table":                                        "path",
+	"yandex_ydb_table_changefeed":                             "name",
+	"yandex_ydb_table_index":                                  "name",
+	"yandex_ydb_topic":                                        "name",
+}
+
+// autonamedField is the auto-named unique name field of a plugin-framework resource: its Pulumi
+// property and the rule its names are generated by.
+type autonamedField struct {
+	key  resource.PropertyKey
+	rule namingRule
+}
+
+// collides reports whether replacing the resource with the Pulumi name resourceName, from olds to
+// news, keeps a name that was set explicitly, so that creating the replacement first would fail.
+// A generated name is regenerated by the engine for the replacement and never collides.
+func (f autonamedField) collides(resourceName string, olds, news resource.PropertyMap) bool {
+	name := news[f.key]
+	if !name.IsString() || !name.DeepEquals(olds[f.key]) {
+		return false
+	}
+	return !f.rule.generated(resourceName, name.StringValue())
+}
+
+// applyDeleteBeforeReplace replaces resources with a unique name delete-before-create whenever
+// the replacement would collide with the old object. The SDKv2 bridge only does that when an
+// auto-named field was set explicitly and kept, so auto-named resources are still replaced
+// create-before-delete. Fields that are never auto-named are always replaced delete-before-create.
+// The plugin-framework bridge diffs without looking at names, so its auto-named resources are
+// returned, keyed by type token, for guardedServer.Diff to apply the same rule to.
+func applyDeleteBeforeReplace(prov *tfbridge.ProviderInfo) map[string]autonamedField {
+	autonamed := map[string]autonamedField{}
+	pf, _ := prov.P.(interface{ ResourceIsPF(string) bool })
+	prov.P.ResourcesMap().Range(func(name string, res shim.Resource) bool {
+		info := prov.Resources[name]
+		field := uniqueNameFields[name]
+		if info == nil || field == "" || info.DeleteBeforeReplace {
+			return true
+		}
+		if _, ok := res.Schema().GetOk(field); !ok {
+			return true
+		}
+		fieldInfo := info.Fields[field]
+		autoNamed := fieldInfo != nil && fieldInfo.HasDefault() && fieldInfo.Default.AutoNamed
+		if !autoNamed {
+			info.DeleteBeforeReplace = true
+			return true
+		}
+		key := tfbridge.TerraformToPulumiNameV2(field, res.Schema(), info.Fields)
+		if pf != nil && pf.ResourceIsPF(name) {
+			rule, ok := autonamedFields[name][field]
+			if !ok {
+				rule = cloudNaming
+			}
+			autonamed[string(info.Tok)] = autonamedField{key: resource.PropertyKey(key), rule: rule}
+			return true
+		}
+		info.UniqueNameFields = []string{key}
+		return true
+	})
+	return autonamed
+}
@@ -0,0 +1,129 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestUniqueNameFieldsComplete(t *testing.T) {
+	prov := Provider()
+
+	prov.P.ResourcesMap().Range(func(name string, res shim.Resource) bool {
+		field, ok := uniqueNameFields[name]
+		if _, _, iam := iamParent(name); iam {
+			if ok {
+				t.Errorf("%s is an IAM resource and should not be listed in uniqueNameFields", name)
+			}
+		} else if !ok {
+			t.Errorf("%s is not classified in uniqueNameFields", name)
+		} else if _, ok := res.Schema().GetOk(field); field != "" && !ok {
+			t.Errorf("%s has no field %q", name, field)
+		}
+		return true
+	})
+	for name := range uniqueNameFields {
+		if _, ok := prov.P.ResourcesMap().GetOk(name); !ok {
+			t.Errorf("uniqueNameFields lists %s, which is not a resource", name)
+		}
+	}
+}
+
+func TestDeleteBeforeReplace(t *testing.T) {
+	prov := Provider()
+
+	tests := []struct {
+		name                string
+		deleteBeforeReplace bool
+		uniqueNameFields    []string
+	}{
+		// Names that are never auto-named always collide.
+		{name: "yandex_dns_recordset", deleteBeforeReplace: true},
+		{name: "yandex_container_repository", deleteBeforeReplace: true},
+		// Auto-named resources only collide when their name is set explicitly.
+		{name: "yandex_mdb_postgresql_database", uniqueNameFields: []string{"name"}},
+		{name: "yandex_mdb_mysql_user", uniqueNameFields: []string{"name"}},
+		{name: "yandex_mdb_kafka_topic", uniqueNameFields: []string{"name"}},
+		{name: "yandex_lockbox_secret", uniqueNameFields: []string{"name"}},
+		{name: "yandex_storage_bucket", uniqueNameFields: []string{"bucket"}},
+		// Auto-named plugin-framework resources are decided by guardedServer.Diff.
+		{name: "yandex_mdb_clickhouse_user"},
+		// Resources without a unique name are replaced create-before-delete.
+		{name: "yandex_iam_service_account_key"},
+		{name: "yandex_resourcemanager_folder_iam_member"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := prov.Resources[tt.name]
+			if info.DeleteBeforeReplace != tt.deleteBeforeReplace {
+				t.Errorf("DeleteBeforeReplace = %v, want %v", info.DeleteBeforeReplace, tt.deleteBeforeReplace)
+			}
+			if !slices.Equal(info.UniqueNameFields, tt.uniqueNameFields) {
+				t.Errorf("UniqueNameFields = %v, want %v", info.UniqueNameFields, tt.uniqueNameFields)
+			}
+		})
+	}
+}
+
+func TestDeleteBeforeReplaceFramework(t *testing.T) {
+	prov, guard := newProvider()
+	tok := prov.Resources["yandex_mdb_clickhouse_user"].Tok
+	urn := resource.NewURN("dev", "proj", "", tokens.Type(tok), "reader")
+	generated := sqlNaming.transform("reader") + sqlNaming.separator + "abc1234"
+
+	state := func(name string) *structpb.Struct {
+		props, err := plugin.MarshalProperties(resource.PropertyMap{
+			"clusterId": resource.NewStringProperty("c9q1"),
+			"name":      resource.NewStringProperty(name),
+		}, plugin.MarshalOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return props
+	}
+
+	tests := []struct {
+		name                string
+		olds, news          string
+		deleteBeforeReplace bool
+	}{
+		{name: "explicit name kept", olds: "reader", news: "reader", deleteBeforeReplace: true},
+		{name: "generated name kept", olds: generated, news: generated},
+		{name: "name changed", olds: "reader", news: "writer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newGuardedServer(&recordingServer{replaces: []string{"clusterId"}}, guard, &recordingLogger{})
+			resp, err := s.Diff(context.Background(), &pulumirpc.DiffRequest{
+				Urn: string(urn), Id: "c9q1:" + tt.olds, Olds: state(tt.olds), News: state(tt.news),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.GetDeleteBeforeReplace() != tt.deleteBeforeReplace {
+				t.Errorf("DeleteBeforeReplace = %v, want %v", resp.GetDeleteBeforeReplace(), tt.deleteBeforeReplace)
+			}
+		})
+	}
+}
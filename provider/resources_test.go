@@ -0,0 +1,226 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+)
+
+func TestAllUpstreamResourcesMapped(t *testing.T) {
+	prov := Provider()
+
+	prov.P.ResourcesMap().Range(func(name string, _ shim.Resource) bool {
+		if r, ok := prov.Resources[name]; !ok || r.Tok == "" {
+			t.Errorf("resource %q is not mapped to a Pulumi token", name)
+		}
+		return true
+	})
+	prov.P.DataSourcesMap().Range(func(name string, _ shim.Resource) bool {
+		if d, ok := prov.DataSources[name]; !ok || d.Tok == "" {
+			t.Errorf("data source %q is not mapped to a Pulumi token", name)
+		}
+		return true
+	})
+}
+
+func TestComputedTokens(t *testing.T) {
+	prov := Provider()
+
+	resources := map[string]string{
+		"yandex_airflow_cluster":           "yandex:airflow/cluster:Cluster",
+		"yandex_compute_instance":          "yandex:compute/instance:Instance",
+		"yandex_dns_recordset":             "yandex:dns/recordSet:RecordSet",
+		"yandex_function_trigger":          "yandex:serverless/functionTrigger:FunctionTrigger",
+		"yandex_mdb_mysql_cluster_v2":      "yandex:mdb/mysqlClusterV2:MysqlClusterV2",
+		"yandex_mdb_opensearch_cluster":    "yandex:mdb/opensearchCluster:OpensearchCluster",
+		"yandex_mdb_postgresql_cluster_v2": "yandex:mdb/postgresqlClusterV2:PostgresqlClusterV2",
+		"yandex_mdb_redis_user":            "yandex:mdb/redisUser:RedisUser",
+		"yandex_mdb_sqlserver_cluster":     "yandex:mdb/sqlServerCluster:SqlServerCluster",
+		"yandex_message_queue":             "yandex:index/messageQueue:MessageQueue",
+		"yandex_trino_catalog":             "yandex:trino/catalog:Catalog",
+		"yandex_vpc_network":               "yandex:vpc/network:Network",
+	}
+	for name, want := range resources {
+		if r, ok := prov.Resources[name]; !ok {
+			t.Errorf("resource %q is not mapped", name)
+		} else if string(r.Tok) != want {
+			t.Errorf("resource %q: got token %q, want %q", name, r.Tok, want)
+		}
+	}
+
+	dataSources := map[string]string{
+		"yandex_client_config":             "yandex:index/getClientConfig:getClientConfig",
+		"yandex_mdb_clickhouse_database":   "yandex:mdb/getClickhouseDatabase:getClickhouseDatabase",
+		"yandex_mdb_clickhouse_user":       "yandex:mdb/getClickhouseUser:getClickhouseUser",
+		"yandex_mdb_mongodb_database":      "yandex:mdb/getMongodbDatabase:getMongodbDatabase",
+		"yandex_mdb_mongodb_user":          "yandex:mdb/getMongodbUser:getMongodbUser",
+		"yandex_mdb_mysql_cluster_v2":      "yandex:mdb/getMysqlClusterV2:getMysqlClusterV2",
+		"yandex_mdb_opensearch_cluster":    "yandex:mdb/getOpensearchCluster:getOpensearchCluster",
+		"yandex_mdb_postgresql_cluster_v2": "yandex:mdb/getPostgresqlClusterV2:getPostgresqlClusterV2",
+		"yandex_mdb_redis_user":            "yandex:mdb/getRedisUser:getRedisUser",
+		"yandex_spark_cluster":             "yandex:spark/getCluster:getCluster",
+		"yandex_vpc_network":               "yandex:vpc/getNetwork:getNetwork",
+	}
+	for name, want := range dataSources {
+		if d, ok := prov.DataSources[name]; !ok {
+			t.Errorf("data source %q is not mapped", name)
+		} else if string(d.Tok) != want {
+			t.Errorf("data source %q: got token %q, want %q", name, d.Tok, want)
+		}
+	}
+}
+
+// TestStandaloneDatabasesAndUsers checks that every standalone database and user resource the
+// README lists has a matching data source.
+func TestStandaloneDatabasesAndUsers(t *testing.T) {
+	prov := Provider()
+	for _, name := range []string{
+		"yandex_mdb_clickhouse_database", "yandex_mdb_clickhouse_user",
+		"yandex_mdb_kafka_topic", "yandex_mdb_kafka_user",
+		"yandex_mdb_mongodb_database", "yandex_mdb_mongodb_user",
+		"yandex_mdb_mysql_database", "yandex_mdb_mysql_user",
+		"yandex_mdb_postgresql_database", "yandex_mdb_postgresql_user",
+		"yandex_mdb_redis_user",
+	} {
+		if _, ok := prov.Resources[name]; !ok {
+			t.Errorf("resource %q is not mapped", name)
+		}
+		if _, ok := prov.DataSources[name]; !ok {
+			t.Errorf("resource %q has no matching data source", name)
+		}
+	}
+}
+
+func TestIamResourceTokens(t *testing.T) {
+	prov := Provider()
+
+	kinds := map[string]string{"_iam_binding": "IamBinding", "_iam_member": "IamMember", "_iam_policy": "IamPolicy"}
+	prov.P.ResourcesMap().Range(func(name string, _ shim.Resource) bool {
+		parent, suffix, ok := iamParent(name)
+		if !ok {
+			return true
+		}
+		var p tfbridge.ResourceInfo
+		if err := serviceStrategy.Resource(parent, &p); err != nil {
+			t.Fatal(err)
+		}
+		want := makeResource(tokenModule(string(p.Tok)), p.Tok.Name().String()+kinds[suffix])
+		if got := prov.Resources[name].Tok; got != want {
+			t.Errorf("resource %q: got token %q, want %q next to its parent", name, got, want)
+		}
+		return true
+	})
+
+	for name, want := range map[string]string{
+		"yandex_api_gateway_iam_member":            "yandex:serverless/apiGatewayIamMember:ApiGatewayIamMember",
+		"yandex_compute_instance_iam_binding":      "yandex:compute/instanceIamBinding:InstanceIamBinding",
+		"yandex_mdb_postgresql_cluster_iam_member": "yandex:mdb/postgresqlClusterIamMember:PostgresqlClusterIamMember",
+		"yandex_resourcemanager_folder_iam_member": "yandex:resourcemanager/folderIamMember:FolderIamMember",
+		"yandex_storage_bucket_iam_binding":        "yandex:storage/bucketIamBinding:BucketIamBinding",
+		"yandex_ydb_database_iam_binding":          "yandex:ydb/databaseIamBinding:DatabaseIamBinding",
+	} {
+		if r, ok := prov.Resources[name]; !ok {
+			t.Errorf("resource %q is not mapped", name)
+		} else if string(r.Tok) != want {
+			t.Errorf("resource %q: got token %q, want %q", name, r.Tok, want)
+		}
+	}
+}
+
+func TestIndexAliases(t *testing.T) {
+	prov := Provider()
+
+	aliases := map[string]string{
+		"yandex_compute_instance":      "yandex:index/computeInstance:ComputeInstance",
+		"yandex_dns_recordset":         "yandex:index/dnsRecordSet:DnsRecordSet",
+		"yandex_function":              "yandex:index/function:Function",
+		"yandex_mdb_sqlserver_cluster": "yandex:index/mdbSqlServerCluster:MdbSqlServerCluster",
+		"yandex_vpc_network":           "yandex:index/vpcNetwork:VpcNetwork",
+
+		"yandex_function_iam_binding":               "yandex:index/functionIamBinding:FunctionIamBinding",
+		"yandex_iam_service_account_iam_member":     "yandex:index/iamServiceAccountIamMember:IamServiceAccountIamMember",
+		"yandex_resourcemanager_folder_iam_binding": "yandex:index/resourcemanagerFolderIamBinding:ResourcemanagerFolderIamBinding",
+	}
+	for name, want := range aliases {
+		r, ok := prov.Resources[name]
+		if !ok {
+			t.Errorf("resource %q is not mapped", name)
+			continue
+		}
+		var found bool
+		for _, alias := range r.Aliases {
+			if alias.Type != nil && *alias.Type == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("resource %q: missing alias to %q", name, want)
+		}
+	}
+}
+
+func TestNoAliasesForNewResources(t *testing.T) {
+	prov := Provider()
+
+	for _, name := range []string{"yandex_mdb_opensearch_cluster", "yandex_mdb_postgresql_cluster_v2"} {
+		r, ok := prov.Resources[name]
+		if !ok {
+			t.Errorf("resource %q is not mapped", name)
+			continue
+		}
+		for _, alias := range r.Aliases {
+			if alias.Type != nil && tokenModule(*alias.Type) == mainMod {
+				t.Errorf("resource %q is aliased to %q, which was never published", name, *alias.Type)
+			}
+		}
+	}
+}
+
+func TestIndexFunctions(t *testing.T) {
+	prov := Provider()
+
+	functions := map[string]string{
+		"yandex_vpc_network":           "yandex:index/getVpcNetwork:getVpcNetwork",
+		"yandex_function":              "yandex:index/getFunction:getFunction",
+		"yandex_mdb_sqlserver_cluster": "yandex:index/getMdbSqlserverCluster:getMdbSqlserverCluster",
+	}
+	for name, want := range functions {
+		current, ok := prov.DataSources[name]
+		if !ok {
+			t.Errorf("data source %q is not mapped", name)
+			continue
+		}
+		legacy, ok := prov.DataSources[name+tfbridge.RenamedEntitySuffix]
+		if !ok {
+			t.Errorf("data source %q has no index function", name)
+			continue
+		}
+		if string(legacy.Tok) != want {
+			t.Errorf("data source %q: got index function %q, want %q", name, legacy.Tok, want)
+		}
+		if legacy.DeprecationMessage == "" {
+			t.Errorf("index function %q is not deprecated", legacy.Tok)
+		}
+		if tokenModule(string(current.Tok)) == mainMod {
+			t.Errorf("data source %q is still in %s", name, mainMod)
+		}
+	}
+	if _, ok := prov.DataSources["yandex_mdb_opensearch_cluster"+tfbridge.RenamedEntitySuffix]; ok {
+		t.Errorf("yandex_mdb_opensearch_cluster was never published in %s", mainMod)
+	}
+}
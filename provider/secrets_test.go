@@ -0,0 +1,191 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfgen"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	pschema "github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+)
+
+func TestSecretFieldsInSchema(t *testing.T) {
+	prov := Provider()
+	// Docs are irrelevant here; an empty upstream checkout keeps tfgen from downloading them.
+	prov.UpstreamRepoPath = t.TempDir()
+	spec, err := tfgen.GenerateSchema(prov, diag.DefaultSink(io.Discard, io.Discard, diag.FormatOptions{
+		Color: colors.Never,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for tfToken, paths := range secretResourceFields {
+		info := prov.Resources[tfToken]
+		if info == nil {
+			t.Errorf("%s is audited but not an upstream resource", tfToken)
+			continue
+		}
+		res, ok := spec.Resources[string(info.Tok)]
+		if !ok {
+			t.Errorf("%s: %s is missing from the schema", tfToken, info.Tok)
+			continue
+		}
+		sch := prov.P.ResourcesMap().Get(tfToken).Schema()
+		for _, path := range paths {
+			checkSecret(t, spec, tfToken+"."+path, res.Properties, sch, info.Fields, path)
+			top, _, _ := strings.Cut(path, ".")
+			if _, isInput := res.InputProperties[tfbridge.TerraformToPulumiNameV2(top, sch, info.Fields)]; isInput {
+				checkSecret(t, spec, tfToken+"."+path+" (input)", res.InputProperties, sch, info.Fields, path)
+			}
+		}
+	}
+
+	for tfToken, paths := range secretDataSourceFields {
+		info := prov.DataSources[tfToken]
+		if info == nil {
+			t.Errorf("%s is audited but not an upstream data source", tfToken)
+			continue
+		}
+		fn, ok := spec.Functions[string(info.Tok)]
+		if !ok || fn.Outputs == nil {
+			t.Errorf("%s: %s is missing from the schema", tfToken, info.Tok)
+			continue
+		}
+		sch := prov.P.DataSourcesMap().Get(tfToken).Schema()
+		for _, path := range paths {
+			checkSecret(t, spec, tfToken+"."+path, fn.Outputs.Properties, sch, info.Fields, path)
+		}
+	}
+}
+
+// credentialWords are the words of a field name that suggest it holds a credential.
+var credentialWords = map[string]bool{"password": true, "passwords": true, "secret": true, "token": true, "key": true}
+
+// TestSecretFieldsAudited sweeps the schema of every resource and data source, nested blocks
+// included, for fields whose name suggests a credential and checks that each is secret, through
+// upstream's Sensitive or through secretResourceFields and secretDataSourceFields.
+func TestSecretFieldsAudited(t *testing.T) {
+	prov := Provider()
+	prov.P.ResourcesMap().Range(func(name string, r shim.Resource) bool {
+		var fields map[string]*tfbridge.SchemaInfo
+		if info := prov.Resources[name]; info != nil {
+			fields = info.Fields
+		}
+		checkCredentialsSecret(t, name, r.Schema(), fields, false)
+		return true
+	})
+	prov.P.DataSourcesMap().Range(func(name string, r shim.Resource) bool {
+		var fields map[string]*tfbridge.SchemaInfo
+		if info := prov.DataSources[name]; info != nil {
+			fields = info.Fields
+		}
+		checkCredentialsSecret(t, name, r.Schema(), fields, false)
+		return true
+	})
+}
+
+// checkCredentialsSecret reports the fields of sch, at any depth, that look like credentials but
+// are not secret. secret is whether the block sch belongs to is itself secret.
+func checkCredentialsSecret(
+	t *testing.T, path string, sch shim.SchemaMap, fields map[string]*tfbridge.SchemaInfo, secret bool,
+) {
+	t.Helper()
+	sch.Range(func(name string, s shim.Schema) bool {
+		info := fields[name]
+		isSecret := secret || s.Sensitive() || (info != nil && info.Secret != nil && *info.Secret)
+		if elem, ok := s.Elem().(shim.Resource); ok {
+			var elemFields map[string]*tfbridge.SchemaInfo
+			if info != nil && info.Elem != nil {
+				elemFields = info.Elem.Fields
+			}
+			checkCredentialsSecret(t, path+"."+name, elem.Schema(), elemFields, isSecret)
+			return true
+		}
+		if s.Type() != shim.TypeBool && looksLikeCredential(name) && !isSecret && !notCredentials[path+"."+name] {
+			t.Errorf("%s.%s looks like a credential but is not secret; add it to the secret fields "+
+				"or, if it holds none, to notCredentials", path, name)
+		}
+		return true
+	})
+}
+
+// looksLikeCredential reports whether a field name contains one of credentialWords, other than as
+// a qualifier of an ID or name, as in kms_key_id or secret_name.
+func looksLikeCredential(name string) bool {
+	words := strings.Split(name, "_")
+	switch words[len(words)-1] {
+	case "id", "ids", "name", "names":
+		return false
+	}
+	for _, w := range words {
+		if credentialWords[w] {
+			return true
+		}
+	}
+	return false
+}
+
+// notCredentials are the fields looksLikeCredential matches that hold no credential.
+var notCredentials = map[string]bool{
+	"yandex_storage_object.key": true, // the object's name in its bucket
+}
+
+// checkSecret follows a dotted Terraform path through nested object types and checks that the
+// property it ends at is secret.
+func checkSecret(
+	t *testing.T, spec pschema.PackageSpec, what string, props map[string]pschema.PropertySpec,
+	sch shim.SchemaMap, fields map[string]*tfbridge.SchemaInfo, path string,
+) {
+	t.Helper()
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		name := tfbridge.TerraformToPulumiNameV2(segment, sch, fields)
+		prop, ok := props[name]
+		if !ok {
+			t.Errorf("%s: no property %q in the schema", what, name)
+			return
+		}
+		if i == len(segments)-1 {
+			if !prop.Secret {
+				t.Errorf("%s is not marked secret", what)
+			}
+			return
+		}
+
+		ref := prop.Ref
+		if prop.Items != nil {
+			ref = prop.Items.Ref
+		}
+		typ, ok := spec.Types[strings.TrimPrefix(ref, "#/types/")]
+		elem, isBlock := sch.Get(segment).Elem().(shim.Resource)
+		if !ok || !isBlock {
+			t.Errorf("%s: %q is not a block", what, segment)
+			return
+		}
+		props, sch = typ.Properties, elem.Schema()
+		if info := fields[segment]; info != nil && info.Elem != nil {
+			fields = info.Elem.Fields
+		} else {
+			fields = nil
+		}
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/airoh-io/pulumi-yandex/provider/pkg/tracing"
+	pf "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/pf/tfbridge"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	rprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Main serves the provider. It does what the bridge's MainWithMuxer does, except that the muxed
+// server is wrapped in a guardedServer, so the provider guard covers the SDKv2 and the
+// plugin-framework halves of the upstream provider alike.
+func Main(ctx context.Context, pkg string, schema []byte) {
+	info, guard := newProvider()
+	handleFlags(info)
+
+	makeServer := pf.MakeMuxedServer(ctx, pkg, info, schema)
+	err := rprovider.Main(pkg, func(host *rprovider.HostClient) (pulumirpc.ResourceProviderServer, error) {
+		server, err := makeServer(host)
+		if err != nil {
+			return nil, err
+		}
+		return newGuardedServer(server, guard, host), nil
+	})
+	if err != nil {
+		cmdutil.ExitError(err.Error())
+	}
+}
+
+// handleFlags answers the -get-provider-info and -version flags tfgen and the release tooling run
+// the provider binary with.
+func handleFlags(info tfbridge.ProviderInfo) {
+	flags := flag.NewFlagSet("tf-provider-flags", flag.ContinueOnError)
+	// Other flags, such as -tracing, are parsed later by the plugin host.
+	flags.SetOutput(io.Discard)
+	dumpInfo := flags.Bool("get-provider-info", false, "dump provider info as JSON to stdout")
+	providerVersion := flags.Bool("version", false, "get built provider version")
+	_ = flags.Parse(os.Args[1:])
+
+	switch {
+	case *dumpInfo:
+		if err := json.NewEncoder(os.Stdout).Encode(tfbridge.MarshalProviderInfo(&info)); err != nil {
+			cmdutil.ExitError(err.Error())
+		}
+		os.Exit(0)
+	case *providerVersion:
+		fmt.Println(info.Version)
+		os.Exit(0)
+	}
+}
+
+// hostLogger is the part of the engine host the server reports warnings through.
+type hostLogger interface {
+	Log(ctx context.Context, sev diag.Severity, urn resource.URN, msg string) error
+}
+
+// guardedServer enforces a providerGuard on the muxed provider server. Every call for a resource or
+// data source passes through it, whichever half of the upstream provider serves it. Creates,
+// updates, deletes, reads and invokes run under the throttle, which caps concurrency per service
+// module and retries quota and availability errors, and each is traced as one span.
+type guardedServer struct {
+	pulumirpc.ResourceProviderServer
+	guard *providerGuard
+	host  hostLogger
+
+	// refresh serializes the reconfigurations of the upstream provider with a refreshed IAM token.
+	// Operations don't hold it: one in flight keeps the token it started with, which stays valid
+	// for a quarter of its lifetime after the refresh. configureReq is the request the provider
+	// was last configured with.
+	refresh      sync.Mutex
+	configureReq *pulumirpc.ConfigureRequest
+}
+
+func newGuardedServer(
+	server pulumirpc.ResourceProviderServer, guard *providerGuard, host hostLogger,
+) pulumirpc.ResourceProviderServer {
+	return &guardedServer{ResourceProviderServer: server, guard: guard, host: host}
+}
+
+// Configure applies the guard options before the upstream provider is configured. When IAM tokens
+// come from an OIDC token exchange, both halves of the upstream provider are configured with the
+// exchanged token as yandex:token, and the request is kept so that they can be reconfigured once
+// the token is due for refresh.
+func (s *guardedServer) Configure(
+	ctx context.Context, req *pulumirpc.ConfigureRequest,
+) (*pulumirpc.ConfigureResponse, error) {
+	vars, err := unmarshalProperties(req.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.guard.configure(vars); err != nil {
+		return nil, err
+	}
+	oidc := s.guard.oidc.Load()
+	if oidc == nil {
+		return s.ResourceProviderServer.Configure(ctx, req)
+	}
+
+	s.refresh.Lock()
+	defer s.refresh.Unlock()
+	token, _, err := oidc.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.configureReq = req
+	return s.ResourceProviderServer.Configure(ctx, withToken(req, token))
+}
+
+func (s *guardedServer) Create(
+	ctx context.Context, req *pulumirpc.CreateRequest,
+) (resp *pulumirpc.CreateResponse, err error) {
+	if !req.GetPreview() {
+		if err := s.checkWritable("create", req.GetUrn()); err != nil {
+			return nil, err
+		}
+	}
+	err = s.run(ctx, "create", req.GetUrn(), "", func(ctx context.Context) (err error) {
+		resp, err = s.ResourceProviderServer.Create(ctx, req)
+		return err
+	}, func(err error) bool {
+		return !resourceInitFailed(err)
+	})
+	return resp, err
+}
+
+func (s *guardedServer) Update(
+	ctx context.Context, req *pulumirpc.UpdateRequest,
+) (resp *pulumirpc.UpdateResponse, err error) {
+	if !req.GetPreview() {
+		if err := s.checkWritable("update", req.GetUrn()); err != nil {
+			return nil, err
+		}
+	}
+	err = s.run(ctx, "update", req.GetUrn(), "", func(ctx context.Context) (err error) {
+		resp, err = s.ResourceProviderServer.Update(ctx, req)
+		return err
+	}, nil)
+	return resp, err
+}
+
+func (s *guardedServer) Delete(ctx context.Context, req *pulumirpc.DeleteRequest) (resp *emptypb.Empty, err error) {
+	if err := s.checkWritable("delete", req.GetUrn()); err != nil {
+		return nil, err
+	}
+	err = s.run(ctx, "delete", req.GetUrn(), "", func(ctx context.Context) (err error) {
+		resp, err = s.ResourceProviderServer.Delete(ctx, req)
+		return err
+	}, nil)
+	return resp, err
+}
+
+func (s *guardedServer) Read(ctx context.Context, req *pulumirpc.ReadRequest) (resp *pulumirpc.ReadResponse, err error) {
+	err = s.run(ctx, "read", req.GetUrn(), "", func(ctx context.Context) (err error) {
+		resp, err = s.ResourceProviderServer.Read(ctx, req)
+		return err
+	}, nil)
+	return resp, err
+}
+
+// Diff warns when a resource that is protected from deletion is about to be replaced, and replaces
+// auto-named plugin-framework resources delete-before-create when they keep an explicit name.
+func (s *guardedServer) Diff(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
+	resp, err := s.ResourceProviderServer.Diff(ctx, req)
+	if err != nil || len(resp.GetReplaces()) == 0 {
+		return resp, err
+	}
+	olds, err := unmarshalProperties(req.GetOlds())
+	if err != nil {
+		return resp, nil
+	}
+	if replacesProtected(olds, resp.GetReplaces()) {
+		s.log(ctx, diag.Warning, req.GetUrn(), fmt.Sprintf("%s has %s enabled but is scheduled for replacement; "+
+			"deleting the old resource will fail until %[2]s is turned off", req.GetUrn(), deletionProtectionKey))
+	}
+	urn := resource.URN(req.GetUrn())
+	if field, ok := s.guard.autonamed[string(urn.Type())]; ok && !resp.GetDeleteBeforeReplace() {
+		if news, err := unmarshalProperties(req.GetNews()); err == nil && field.collides(urn.Name(), olds, news) {
+			resp.DeleteBeforeReplace = true
+		}
+	}
+	return resp, nil
+}
+
+// Invoke checks the folder and cloud of data sources against the allow-lists.
+func (s *guardedServer) Invoke(
+	ctx context.Context, req *pulumirpc.InvokeRequest,
+) (resp *pulumirpc.InvokeResponse, err error) {
+	scope := s.guard.scope.Load()
+	if fields, ok := s.guard.invokes[req.GetTok()]; ok && scope != nil {
+		args, err := unmarshalProperties(req.GetArgs())
+		if err != nil {
+			return nil, err
+		}
+		if err := scope.checkInvoke(req.GetTok(), fields, args); err != nil {
+			return nil, err
+		}
+	}
+	err = s.run(ctx, "invoke", "", req.GetTok(), func(ctx context.Context) (err error) {
+		resp, err = s.ResourceProviderServer.Invoke(ctx, req)
+		return err
+	}, nil)
+	return resp, err
+}
+
+// run runs call, the operation op on the resource urn or the function tok, as one span and under
+// the configured throttle, or directly before the provider is configured. A nil retryable means
+// every failed attempt may be repeated.
+func (s *guardedServer) run(
+	ctx context.Context, op, urn, tok string, call func(context.Context) error, retryable func(error) bool,
+) (err error) {
+	name := tok
+	if urn != "" {
+		tok, name = string(resource.URN(urn).Type()), urn
+	}
+	ctx, span := tracing.StartOperation(ctx, op, tok, urn)
+	defer func() { tracing.End(span, err) }()
+
+	attempt := func() error {
+		if err := s.refreshCredentials(ctx); err != nil {
+			return err
+		}
+		return call(ctx)
+	}
+	th := s.guard.throttle.Load()
+	if th == nil {
+		return attempt()
+	}
+	if retryable == nil {
+		retryable = func(error) bool { return true }
+	}
+	log := func(sev diag.Severity, msg string) { s.log(ctx, sev, urn, msg) }
+	return th.do(ctx, tok, op+" "+name, log, attempt, retryable)
+}
+
+// refreshCredentials reconfigures the upstream provider when the IAM token obtained by OIDC token
+// exchange is due for refresh. It waits for a refresh another operation is making, but never for
+// the operations in flight.
+func (s *guardedServer) refreshCredentials(ctx context.Context) error {
+	oidc := s.guard.oidc.Load()
+	if oidc == nil || !oidc.expiring() {
+		return nil
+	}
+	s.refresh.Lock()
+	defer s.refresh.Unlock()
+	return s.refreshToken(ctx, oidc)
+}
+
+// refreshToken exchanges a new IAM token and reconfigures the upstream provider with it, unless
+// another operation already has. It must be called with s.refresh held.
+func (s *guardedServer) refreshToken(ctx context.Context, oidc *oidcTokenSource) error {
+	token, refreshed, err := oidc.Token(ctx)
+	if err != nil || !refreshed {
+		return err
+	}
+	if s.configureReq == nil {
+		return fmt.Errorf("cannot refresh the IAM token: the provider is not configured")
+	}
+	if _, err := s.ResourceProviderServer.Configure(ctx, withToken(s.configureReq, token)); err != nil {
+		return fmt.Errorf("reconfiguring the provider with a refreshed IAM token: %w", err)
+	}
+	s.log(ctx, diag.Info, "", "refreshed the IAM token obtained by OIDC token exchange")
+	return nil
+}
+
+// withToken returns a copy of req that configures the upstream provider with the IAM token.
+func withToken(req *pulumirpc.ConfigureRequest, token string) *pulumirpc.ConfigureRequest {
+	req = proto.Clone(req).(*pulumirpc.ConfigureRequest)
+	if req.Args != nil {
+		if req.Args.Fields == nil {
+			req.Args.Fields = map[string]*structpb.Value{}
+		}
+		req.Args.Fields["token"] = structpb.NewStringValue(token)
+	}
+	if req.Variables == nil {
+		req.Variables = map[string]string{}
+	}
+	req.Variables["yandex:config:token"] = token
+	return req
+}
+
+// checkWritable refuses op on the resource urn when the provider is read-only.
+func (s *guardedServer) checkWritable(op, urn string) error {
+	if s.guard.readOnly.Load() {
+		return fmt.Errorf("refusing to %s %s: the provider is read-only (yandex:%s or %s is set)",
+			op, urn, readOnlyKey, readOnlyEnv)
+	}
+	return nil
+}
+
+// log reports msg to the engine. Failing to log is not worth failing the operation for.
+func (s *guardedServer) log(ctx context.Context, sev diag.Severity, urn, msg string) {
+	if s.host != nil {
+		_ = s.host.Log(ctx, sev, resource.URN(urn), msg)
+	}
+}
+
+// unmarshalProperties decodes the properties of a request, keeping unknowns and secrets.
+func unmarshalProperties(props *structpb.Struct) (resource.PropertyMap, error) {
+	return plugin.UnmarshalProperties(props, plugin.MarshalOptions{
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+		SkipNulls:    true,
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// recordingServer stands in for the muxed provider server and records the calls that reach it.
+type recordingServer struct {
+	pulumirpc.UnimplementedResourceProviderServer
+	calls      []string
+	replaces   []string
+	configured []*pulumirpc.ConfigureRequest
+}
+
+func (s *recordingServer) Create(context.Context, *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+	s.calls = append(s.calls, "create")
+	return &pulumirpc.CreateResponse{Id: "c9q1"}, nil
+}
+
+func (s *recordingServer) Update(context.Context, *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
+	s.calls = append(s.calls, "update")
+	return &pulumirpc.UpdateResponse{}, nil
+}
+
+func (s *recordingServer) Delete(context.Context, *pulumirpc.DeleteRequest) (*emptypb.Empty, error) {
+	s.calls = append(s.calls, "delete")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *recordingServer) Diff(context.Context, *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
+	s.calls = append(s.calls, "diff")
+	return &pulumirpc.DiffResponse{Replaces: s.replaces}, nil
+}
+
+func (s *recordingServer) Invoke(context.Context, *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	s.calls = append(s.calls, "invoke")
+	return &pulumirpc.InvokeResponse{}, nil
+}
+
+func (s *recordingServer) Read(context.Context, *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	s.calls = append(s.calls, "read")
+	return &pulumirpc.ReadResponse{}, nil
+}
+
+func (s *recordingServer) Configure(
+	_ context.Context, req *pulumirpc.ConfigureRequest,
+) (*pulumirpc.ConfigureResponse, error) {
+	s.calls = append(s.calls, "configure")
+	s.configured = append(s.configured, req)
+	return &pulumirpc.ConfigureResponse{}, nil
+}
+
+// recordingLogger stands in for the engine host and records the messages logged to it.
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Log(_ context.Context, _ diag.Severity, _ resource.URN, msg string) error {
+	l.messages = append(l.messages, msg)
+	return nil
+}
+
+func (l *recordingLogger) LogStatus(ctx context.Context, sev diag.Severity, urn resource.URN, msg string) error {
+	return l.Log(ctx, sev, urn, msg)
+}
+
+// configureServer configures s with the given provider options, as the engine does.
+func configureServer(t *testing.T, s pulumirpc.ResourceProviderServer, vars map[string]interface{}) error {
+	t.Helper()
+	args, err := plugin.MarshalProperties(resource.NewPropertyMapFromMap(vars), plugin.MarshalOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Configure(context.Background(), &pulumirpc.ConfigureRequest{Args: args})
+	return err
+}
+
+// TestServerSpans runs Managed OpenSearch, which the plugin-framework half of the upstream provider
+// serves, through the guarded server and checks that every operation is one span.
+func TestServerSpans(t *testing.T) {
+	const urn = "urn:pulumi:prod::infra::yandex:mdb/opensearchCluster:OpensearchCluster::search"
+	rec := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx := context.Background()
+	s := newGuardedServer(&recordingServer{}, &providerGuard{}, nil)
+	if _, err := s.Create(ctx, &pulumirpc.CreateRequest{Urn: urn}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Delete(ctx, &pulumirpc.DeleteRequest{Urn: urn, Id: "c9q1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Invoke(ctx, &pulumirpc.InvokeRequest{Tok: "yandex:mdb/getOpensearchCluster:getOpensearchCluster"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, span := range rec.Ended() {
+		names = append(names, span.Name())
+	}
+	want := []string{
+		"create yandex:mdb/opensearchCluster:OpensearchCluster",
+		"delete yandex:mdb/opensearchCluster:OpensearchCluster",
+		"invoke yandex:mdb/getOpensearchCluster:getOpensearchCluster",
+	}
+	if strings.Join(names, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got spans %q, want %q", names, want)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/airoh-io/pulumi-yandex/provider/pkg/tracing"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serviceConcurrencyKey = "serviceConcurrency"
+	quotaRetriesKey       = "quotaRetries"
+
+	defaultQuotaRetries = 3
+	retryBaseDelay      = time.Second
+	retryMaxDelay       = 30 * time.Second
+)
+
+// serviceConcurrencyConfig and quotaRetriesConfig are the Pulumi-only throttling provider options.
+var (
+	serviceConcurrencyConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeMap,
+			Optional: true,
+			Elem:     (&schema.Schema{Type: shim.TypeInt}).Shim(),
+			Description: "Maximum number of concurrent operations per service module, for example " +
+				`{"compute": 4, "mdb": 2}. Services that are not listed are not limited.`,
+		}).Shim(),
+	}
+	quotaRetriesConfig = &tfbridge.ConfigInfo{
+		Schema: (&schema.Schema{
+			Type:     shim.TypeInt,
+			Optional: true,
+			Description: "How many times an operation that failed with RESOURCE_EXHAUSTED or UNAVAILABLE is " +
+				"retried, with exponential backoff and jitter. Defaults to 3; 0 turns retries off.",
+		}).Shim(),
+	}
+)
+
+// logFunc reports a message about an operation to the engine.
+type logFunc func(sev diag.Severity, msg string)
+
+// throttle limits concurrent operations per service and retries operations rejected by quotas.
+type throttle struct {
+	retries int
+	caps    map[string]int
+
+	mu   sync.Mutex
+	sems map[string]chan struct{}
+
+	// sleep waits between retries; tests replace it.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newThrottle(meta resource.PropertyMap) (*throttle, error) {
+	caps, err := configIntMap(meta, serviceConcurrencyKey)
+	if err != nil {
+		return nil, err
+	}
+	for svc, n := range caps {
+		if !isServiceModule(svc) {
+			return nil, checkFailure(serviceConcurrencyKey, "yandex:%s: unknown service %q, expected one of %s",
+				serviceConcurrencyKey, svc, strings.Join(serviceModuleNames(), ", "))
+		}
+		if n < 1 {
+			return nil, checkFailure(serviceConcurrencyKey, "yandex:%s: the limit for %q must be at least 1",
+				serviceConcurrencyKey, svc)
+		}
+	}
+
+	retries, set, err := configInt(meta, quotaRetriesKey)
+	switch {
+	case err != nil:
+		return nil, err
+	case !set:
+		retries = defaultQuotaRetries
+	case retries < 0:
+		return nil, checkFailure(quotaRetriesKey, "yandex:%s must not be negative", quotaRetriesKey)
+	}
+
+	return &throttle{
+		retries: retries,
+		caps:    caps,
+		sems:    map[string]chan struct{}{},
+		sleep:   sleepContext,
+	}, nil
+}
+
+// do runs op for the resource or function tok, holding a slot of its service and retrying quota and
+// availability errors. name describes the operation in the messages reported through log, and
+// retryable reports whether a failed attempt may be repeated.
+func (th *throttle) do(
+	ctx context.Context, tok, name string, log logFunc, op func() error, retryable func(error) bool,
+) error {
+	release, err := th.acquire(ctx, tokenModule(tok))
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	span := trace.SpanFromContext(ctx)
+	for attempt := 0; ; attempt++ {
+		err := op()
+		if attempt > 0 {
+			span.SetAttributes(tracing.RetriesKey.Int(attempt))
+		}
+		if err == nil {
+			if attempt > 0 {
+				log(diag.Info, fmt.Sprintf("%s succeeded after %d %s", name, attempt, plural(attempt, "retry", "retries")))
+			}
+			return nil
+		}
+		if !isQuotaError(err) || !retryable(err) {
+			return err
+		}
+		if attempt == th.retries {
+			if attempt == 0 {
+				return err
+			}
+			return fmt.Errorf("%w (gave up after %d %s)", err, attempt, plural(attempt, "retry", "retries"))
+		}
+
+		delay := backoff(attempt)
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt+1),
+			attribute.String("delay", delay.String()),
+			attribute.String("error", err.Error()),
+		))
+		log(diag.Warning, fmt.Sprintf("%s: %v; retry %d of %d in %s", name, err,
+			attempt+1, th.retries, delay.Round(time.Millisecond)))
+		if err := th.sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// acquire takes a concurrency slot for the service module svc, if the service is limited.
+func (th *throttle) acquire(ctx context.Context, svc string) (func(), error) {
+	limit, ok := th.caps[svc]
+	if !ok {
+		return func() {}, nil
+	}
+
+	th.mu.Lock()
+	sem, ok := th.sems[svc]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		th.sems[svc] = sem
+	}
+	th.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// backoff returns the delay before retry attempt+1: a random duration up to an exponentially
+// growing cap ("full jitter").
+func backoff(attempt int) time.Duration {
+	ceiling := retryBaseDelay << attempt
+	if ceiling <= 0 || ceiling > retryMaxDelay {
+		ceiling = retryMaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(ceiling))) + time.Millisecond
+}
+
+// quotaMessages are the texts of Yandex Cloud quota and rate limit errors. The upstream provider
+// flattens the gRPC errors of the Yandex API into plain ones, which reach the bridge as
+// codes.Unknown, so only their message tells them apart.
+var quotaMessages = []string{"Quota limit", "RESOURCE_EXHAUSTED", "code = ResourceExhausted", "Too many requests"}
+
+// isQuotaError reports whether err, or an error it wraps, is a RESOURCE_EXHAUSTED or UNAVAILABLE
+// error, or carries the message of a Yandex Cloud quota error.
+func isQuotaError(err error) bool {
+	var s interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &s) {
+		switch s.GRPCStatus().Code() {
+		case codes.ResourceExhausted, codes.Unavailable:
+			return true
+		}
+	}
+	msg := err.Error()
+	for _, m := range quotaMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceInitFailed reports whether err says that a create made the resource but could not finish
+// initializing it. The engine records such a resource as created; repeating the create would make
+// a second one.
+func resourceInitFailed(err error) bool {
+	for _, d := range status.Convert(err).Details() {
+		if _, ok := d.(*pulumirpc.ErrorResourceInitFailed); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func isServiceModule(name string) bool {
+	if name == mainMod {
+		return true
+	}
+	for _, mod := range serviceModules {
+		if mod == name {
+			return true
+		}
+	}
+	return false
+}
+
+func serviceModuleNames() []string {
+	set := map[string]bool{mainMod: true}
+	for _, mod := range serviceModules {
+		set[mod] = true
+	}
+	return sortedKeys(set)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,256 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyServer fails its first `failures` creates and invokes with err and then succeeds.
+type flakyServer struct {
+	pulumirpc.UnimplementedResourceProviderServer
+	failures int
+	err      error
+	calls    int
+}
+
+func (s *flakyServer) fail() error {
+	s.calls++
+	if s.calls <= s.failures {
+		return s.err
+	}
+	return nil
+}
+
+func (s *flakyServer) Create(context.Context, *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &pulumirpc.CreateResponse{Id: "c9q1"}, nil
+}
+
+func (s *flakyServer) Invoke(context.Context, *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &pulumirpc.InvokeResponse{}, nil
+}
+
+func testThrottle(t *testing.T, vars map[string]interface{}) (*throttle, *[]time.Duration) {
+	t.Helper()
+	th, err := newThrottle(resource.NewPropertyMapFromMap(vars))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var delays []time.Duration
+	th.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	return th, &delays
+}
+
+// TestThrottleRetries runs the operations of Managed OpenSearch, which the plugin-framework half of
+// the upstream provider serves, through the guarded server.
+func TestThrottleRetries(t *testing.T) {
+	const urn = "urn:pulumi:prod::infra::yandex:mdb/opensearchCluster:OpensearchCluster::search"
+	exhausted := status.Error(codes.ResourceExhausted, "Quota limit mdb.opensearch.clusters.count exceeded")
+	initFailed := rpcerror.WithDetails(rpcerror.New(codes.Unavailable, "cluster is not running"),
+		&pulumirpc.ErrorResourceInitFailed{Id: "c9q1"})
+
+	tests := []struct {
+		name     string
+		invoke   bool
+		failures int
+		err      error
+		calls    int
+		wantErr  string
+	}{
+		{name: "recovers", failures: 2, err: exhausted, calls: 3},
+		{name: "gives up", failures: 10, err: exhausted, calls: 4, wantErr: "gave up after 3 retries"},
+		{name: "wrapped", failures: 1, err: fmt.Errorf("creating cluster: %w", exhausted), calls: 2},
+		{name: "invoke", invoke: true, failures: 1, err: status.Error(codes.Unavailable, "try again"), calls: 2},
+		{
+			// The upstream provider flattens API errors; the bridge reports them as codes.Unknown.
+			name: "flattened quota error", failures: 1, calls: 2,
+			err: status.Error(codes.Unknown, "error while requesting API to create cluster: "+
+				"rpc error: code = ResourceExhausted desc = Quota limit mdb.opensearch.clusters.count exceeded"),
+		},
+		{
+			name: "plain quota error", failures: 1, calls: 2,
+			err: fmt.Errorf("creating cluster: %w", errors.New("Quota limit mdb.opensearch.clusters.count exceeded")),
+		},
+		{
+			name: "rate limited", failures: 1, calls: 2,
+			err: errors.New("error while requesting API to create cluster: Too many requests"),
+		},
+		{
+			name: "flattened unavailable", failures: 1, calls: 1, wantErr: "code = Unavailable",
+			err: errors.New("error while requesting API to create cluster: rpc error: code = Unavailable desc = try again"),
+		},
+		{name: "other errors", failures: 1, err: status.Error(codes.InvalidArgument, "bad name"), calls: 1, wantErr: "bad name"},
+		{name: "initialization failed", failures: 1, err: initFailed, calls: 1, wantErr: "not running"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, delays := testThrottle(t, nil)
+			guard := &providerGuard{}
+			guard.throttle.Store(th)
+			inner := &flakyServer{failures: tt.failures, err: tt.err}
+			logger := &recordingLogger{}
+			s := newGuardedServer(inner, guard, logger)
+
+			ctx := context.Background()
+			var err error
+			if tt.invoke {
+				_, err = s.Invoke(ctx, &pulumirpc.InvokeRequest{Tok: "yandex:mdb/getOpensearchCluster:getOpensearchCluster"})
+			} else {
+				_, err = s.Create(ctx, &pulumirpc.CreateRequest{Urn: urn})
+			}
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("got error %v, want it to contain %q", err, tt.wantErr)
+			}
+			if inner.calls != tt.calls {
+				t.Errorf("got %d calls, want %d", inner.calls, tt.calls)
+			}
+			if len(*delays) != tt.calls-1 {
+				t.Errorf("slept %d times between %d calls", len(*delays), tt.calls)
+			}
+			// Each retry is reported, and so is the success after one.
+			if wantLogs := tt.calls - 1; err == nil && wantLogs > 0 {
+				wantLogs++
+				if len(logger.messages) != wantLogs {
+					t.Errorf("got messages %q, want %d", logger.messages, wantLogs)
+				}
+			}
+		})
+	}
+}
+
+func TestThrottleConcurrency(t *testing.T) {
+	th, _ := testThrottle(t, map[string]interface{}{"serviceConcurrency": map[string]interface{}{"compute": 2}})
+
+	var running, peak, other atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = th.do(context.Background(), "yandex:compute/disk:Disk", "creating", discard, func() error {
+				n := running.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				running.Add(-1)
+				return nil
+			}, func(error) bool { return true })
+		}()
+	}
+	// Services without a cap are never blocked by the capped ones.
+	_ = th.do(context.Background(), "yandex:vpc/getNetwork:getNetwork", "invoking", discard, func() error {
+		other.Add(1)
+		return nil
+	}, func(error) bool { return true })
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Errorf("%d compute operations ran at once, want at most 2", got)
+	}
+	if other.Load() != 1 {
+		t.Errorf("vpc operation did not run")
+	}
+}
+
+func discard(diag.Severity, string) {}
+
+func TestThrottleConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		vars    map[string]interface{}
+		retries int
+		wantErr string
+	}{
+		{name: "defaults", retries: defaultQuotaRetries},
+		{name: "retries", vars: map[string]interface{}{"quotaRetries": 0}, retries: 0},
+		{name: "retries as string", vars: map[string]interface{}{"quotaRetries": "5"}, retries: 5},
+		{name: "negative retries", vars: map[string]interface{}{"quotaRetries": -1}, wantErr: "must not be negative"},
+		{
+			name: "caps as JSON", vars: map[string]interface{}{"serviceConcurrency": `{"mdb": 1, "index": 3}`},
+			retries: defaultQuotaRetries,
+		},
+		{
+			name:    "unknown service",
+			vars:    map[string]interface{}{"serviceConcurrency": map[string]interface{}{"kompute": 2}},
+			wantErr: `unknown service "kompute"`,
+		},
+		{
+			name:    "zero cap",
+			vars:    map[string]interface{}{"serviceConcurrency": map[string]interface{}{"iam": 0}},
+			wantErr: "at least 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, err := newThrottle(resource.NewPropertyMapFromMap(tt.vars))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if th.retries != tt.retries {
+				t.Errorf("got %d retries, want %d", th.retries, tt.retries)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		ceiling := retryBaseDelay << attempt
+		if ceiling > retryMaxDelay {
+			ceiling = retryMaxDelay
+		}
+		for i := 0; i < 100; i++ {
+			if d := backoff(attempt); d <= 0 || d > ceiling+time.Millisecond {
+				t.Fatalf("backoff(%d) = %s, want it within (0, %s]", attempt, d, ceiling)
+			}
+		}
+	}
+}